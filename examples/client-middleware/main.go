@@ -25,13 +25,11 @@ func run() (err error) {
 		Password: "password",
 	}
 
-	client, err := reddit.NewClient(nil, credentials)
+	client, err := reddit.NewClient(nil, credentials, reddit.WithMiddleware(logResponse))
 	if err != nil {
 		return
 	}
 
-	client.OnRequestCompleted(logResponse)
-
 	client.Subreddit.Search(ctx, "programming", nil)
 	client.Subreddit.SearchNames(ctx, "monitor")
 	client.Subreddit.SearchPosts(ctx, "react", "webdev", nil)
@@ -39,13 +37,27 @@ func run() (err error) {
 		ListOptions: reddit.ListOptions{
 			Limit: 50,
 		},
-		Sort: "top",
-		Time: "month",
+		Sort: reddit.SortTop,
+		Time: reddit.TimeFilterMonth,
 	})
 
 	return
 }
 
-func logResponse(req *http.Request, res *http.Response) {
-	fmt.Printf("%s %s %s\n", req.Method, req.URL, res.Status)
+func logResponse(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := next.RoundTrip(req)
+		if err != nil {
+			fmt.Printf("%s %s %v\n", req.Method, req.URL, err)
+			return res, err
+		}
+		fmt.Printf("%s %s %s\n", req.Method, req.URL, res.Status)
+		return res, nil
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
 }
@@ -36,7 +36,7 @@ func run() (err error) {
 		ListOptions: reddit.ListOptions{
 			Limit: 100,
 		},
-		Time: "all",
+		Time: reddit.TimeFilterAll,
 	})
 	if err != nil {
 		return
@@ -53,7 +53,7 @@ func run() (err error) {
 			Limit: 100,
 			After: result.After,
 		},
-		Time: "all",
+		Time: reddit.TimeFilterAll,
 	})
 	if err != nil {
 		return
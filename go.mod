@@ -1,10 +1,19 @@
 module github.com/vartanbeno/go-reddit
 
-go 1.14
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.0.0
-	github.com/stretchr/testify v1.5.1
-	golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e
+	github.com/stretchr/testify v1.8.4
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	golang.org/x/time v0.5.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.2.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e // indirect
+	google.golang.org/appengine v1.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/vartanbeno/go-reddit/otelreddit"
+	"github.com/vartanbeno/go-reddit/reddit"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var ctx = context.Background()
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() (err error) {
+	// Spans are kept in memory here and printed at the end.
+	// In practice, the tracer provider would export them, e.g. using OTLP.
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithSyncer(exporter))
+	defer tp.Shutdown(ctx)
+
+	credentials := &reddit.Credentials{
+		ID:       "id",
+		Secret:   "secret",
+		Username: "username",
+		Password: "password",
+	}
+
+	client, err := reddit.NewClient(nil, credentials, otelreddit.WithTracing(tp))
+	if err != nil {
+		return
+	}
+
+	_, _, err = client.Subreddit.HotPosts(ctx, "golang", nil)
+	if err != nil {
+		return
+	}
+
+	for _, span := range exporter.GetSpans() {
+		fmt.Fprintf(os.Stdout, "%s %s (%s)\n", span.SpanContext.TraceID(), span.Name, span.EndTime.Sub(span.StartTime))
+	}
+
+	return
+}
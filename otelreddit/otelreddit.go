@@ -0,0 +1,132 @@
+// Package otelreddit adds OpenTelemetry tracing to a reddit.Client.
+//
+// It lives in its own module so that users of the reddit package who don't use
+// OpenTelemetry don't depend on it.
+package otelreddit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vartanbeno/go-reddit/reddit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/vartanbeno/go-reddit/otelreddit"
+
+// WithTracing returns a client option that creates a span for every request sent by the client,
+// using the provided tracer provider. The W3C traceparent header is added to outgoing requests.
+//
+// The span is named reddit.{service}.{method}, derived from the request's path,
+// e.g. a request to r/golang/hot creates a span named reddit.subreddit.hot.
+func WithTracing(tp trace.TracerProvider) reddit.Opt {
+	return reddit.WithMiddleware(Middleware(tp))
+}
+
+// Middleware returns the middleware used by WithTracing, to be combined with other middlewares.
+func Middleware(tp trace.TracerProvider) reddit.Middleware {
+	tracer := tp.Tracer(instrumentationName)
+	propagator := propagation.TraceContext{}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(req.Context(), SpanName(req.URL.Path),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("http.method", req.Method),
+					attribute.String("http.url", req.URL.String()),
+				),
+			)
+			defer span.End()
+
+			req2 := req.Clone(ctx)
+			propagator.Inject(ctx, propagation.HeaderCarrier(req2.Header))
+
+			resp, err := next.RoundTrip(req2)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+			if v, err := strconv.ParseFloat(resp.Header.Get("X-Ratelimit-Remaining"), 64); err == nil {
+				span.SetAttributes(attribute.Float64("reddit.ratelimit.remaining", v))
+			}
+			if v, err := strconv.ParseFloat(resp.Header.Get("X-Ratelimit-Reset"), 64); err == nil {
+				span.SetAttributes(attribute.Float64("reddit.ratelimit.reset", v))
+			}
+			if resp.StatusCode >= 400 {
+				span.SetStatus(codes.Error, resp.Status)
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+// SpanName returns the name of the span for a request to the provided path.
+// Names and IDs are left out of it so that the number of distinct names stays small, e.g.
+//
+//	r/golang/hot                -> reddit.subreddit.hot
+//	user/spez/about             -> reddit.user.about
+//	api/v1/me                   -> reddit.api.me
+//	comments/abc123             -> reddit.comments
+//	/                           -> reddit.frontpage
+func SpanName(urlPath string) string {
+	urlPath = strings.TrimSuffix(strings.Trim(urlPath, "/"), ".json")
+
+	var segments []string
+	for _, s := range strings.Split(urlPath, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) == 0 {
+		return "reddit.frontpage"
+	}
+
+	var service string
+	var rest []string
+	switch {
+	case segments[0] == "r" && len(segments) > 1:
+		service, rest = "subreddit", segments[2:]
+	case (segments[0] == "user" || segments[0] == "u") && len(segments) > 1:
+		service, rest = "user", segments[2:]
+	case segments[0] == "api":
+		service, rest = "api", segments[1:]
+	default:
+		return "reddit." + segments[0]
+	}
+
+	// skip the api prefix and its version, e.g. r/golang/api/submit or api/v1/me
+	if len(rest) > 0 && rest[0] == "api" {
+		rest = rest[1:]
+	}
+	if len(rest) > 0 && isVersion(rest[0]) {
+		rest = rest[1:]
+	}
+
+	if len(rest) == 0 {
+		return "reddit." + service
+	}
+	return "reddit." + service + "." + rest[0]
+}
+
+func isVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(s[1:])
+	return err == nil
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
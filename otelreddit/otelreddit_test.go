@@ -0,0 +1,136 @@
+package otelreddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vartanbeno/go-reddit/reddit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setup(t *testing.T, tp trace.TracerProvider) (*reddit.Client, *http.ServeMux, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token1", "token_type": "bearer", "expires_in": 3600, "scope": "*"}`)
+	})
+
+	client, err := reddit.NewClient(nil,
+		&reddit.Credentials{ID: "id1", Secret: "secret1", Username: "user1", Password: "password1"},
+		reddit.WithBaseURL(server.URL),
+		reddit.WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithTracing(tp),
+	)
+	require.NoError(t, err)
+
+	return client, mux, server.Close
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestWithTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, mux, teardown := setup(t, tp)
+	defer teardown()
+
+	var traceparent string
+	mux.HandleFunc("/r/golang/hot", func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("X-Ratelimit-Remaining", "598.0")
+		w.Header().Set("X-Ratelimit-Reset", "312")
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+	})
+
+	_, _, err := client.Subreddit.HotPosts(context.Background(), "golang", nil)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "reddit.api.access_token", spans[0].Name())
+
+	span := spans[1]
+	require.Equal(t, "reddit.subreddit.hot", span.Name())
+	require.Equal(t, trace.SpanKindClient, span.SpanKind())
+	require.Equal(t, codes.Unset, span.Status().Code)
+
+	attrs := attributes(span)
+	require.Equal(t, http.MethodGet, attrs["http.method"].AsString())
+	require.Equal(t, client.BaseURL.String()+"/r/golang/hot", attrs["http.url"].AsString())
+	require.Equal(t, int64(http.StatusOK), attrs["http.status_code"].AsInt64())
+	require.Equal(t, 598.0, attrs["reddit.ratelimit.remaining"].AsFloat64())
+	require.Equal(t, 312.0, attrs["reddit.ratelimit.reset"].AsFloat64())
+
+	want := fmt.Sprintf("00-%s-%s-01", span.SpanContext().TraceID(), span.SpanContext().SpanID())
+	require.Equal(t, want, traceparent)
+}
+
+func TestWithTracing_Errors(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, mux, teardown := setup(t, tp)
+	defer teardown()
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Subreddit.Get(context.Background(), "golang")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "reddit.subreddit.about", spans[1].Name())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Equal(t, int64(http.StatusNotFound), attributes(spans[1])["http.status_code"].AsInt64())
+
+	// the server is closed, so the request fails before getting a response
+	teardown()
+	_, _, err = client.Subreddit.Get(context.Background(), "golang")
+	require.Error(t, err)
+
+	spans = recorder.Ended()
+	require.Len(t, spans, 3)
+	require.Equal(t, codes.Error, spans[2].Status().Code)
+	require.Len(t, spans[2].Events(), 1)
+	require.Equal(t, "exception", spans[2].Events()[0].Name)
+}
+
+func TestSpanName(t *testing.T) {
+	tests := map[string]string{
+		"/r/golang/hot":                      "reddit.subreddit.hot",
+		"/r/golang/about/moderators":         "reddit.subreddit.about",
+		"/r/golang/api/submit_text":          "reddit.subreddit.submit_text",
+		"/r/golang":                          "reddit.subreddit",
+		"/user/spez/about":                   "reddit.user.about",
+		"/api/v1/me":                         "reddit.api.me",
+		"/api/vote":                          "reddit.api.vote",
+		"/api/submit_gallery_post.json":      "reddit.api.submit_gallery_post",
+		"/comments/abc123":                   "reddit.comments",
+		"/best":                              "reddit.best",
+		"/":                                  "reddit.frontpage",
+		"":                                   "reddit.frontpage",
+		"/r/golang/comments/abc123/a_title/": "reddit.subreddit.comments",
+	}
+	for path, want := range tests {
+		require.Equal(t, want, SpanName(path), path)
+	}
+}
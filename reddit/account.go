@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 )
@@ -317,6 +318,26 @@ func (s *AccountService) Trophies(ctx context.Context) ([]Trophy, *Response, err
 	return trophies, resp, nil
 }
 
+// NeedsCaptcha reports whether you need to solve a captcha for actions such as submitting posts,
+// which is typically the case for new or low karma accounts.
+// Actions that fail because of a missing or wrong captcha return an error matching ErrBadCaptcha.
+func (s *AccountService) NeedsCaptcha(ctx context.Context) (bool, *Response, error) {
+	path := "api/needs_captcha"
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var root bool
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return root, resp, nil
+}
+
 // Friends returns a list of your friends.
 func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/friends"
@@ -397,8 +418,7 @@ func (s *AccountService) Trusted(ctx context.Context) ([]Relationship, *Response
 func (s *AccountService) AddTrusted(ctx context.Context, username string) (*Response, error) {
 	path := "api/add_whitelisted"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("name", username)
 	// todo: you can also do this with the user id. form.Set("id", id). should we? or is this enough?
 
@@ -426,3 +446,36 @@ func (s *AccountService) RemoveTrusted(ctx context.Context, username string) (*R
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// DeleteAccount permanently deletes the account the client is authenticated as.
+// This cannot be undone, so confirm must be true, otherwise an error is returned without
+// making any request. Only try this against throwaway accounts.
+// The reason is optional and is sent to Reddit as the account's deletion message.
+func (s *AccountService) DeleteAccount(ctx context.Context, password, reason string, confirm bool) (*Response, error) {
+	if !confirm {
+		return nil, errors.New("confirm: must be true to delete the account, this cannot be undone")
+	}
+	if s.client.Username == "" {
+		return nil, errors.New("username: the client must be authenticated as a user to delete its account")
+	}
+	if password == "" {
+		return nil, errors.New("password: cannot be empty")
+	}
+
+	path := "api/delete_user"
+
+	form := newJSONForm()
+	form.Set("user", s.client.Username)
+	form.Set("passwd", password)
+	form.Set("confirm", "true")
+	if reason != "" {
+		form.Set("delete_message", reason)
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
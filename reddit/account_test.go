@@ -15,6 +15,7 @@ var expectedInfo = &User{
 	ID:               "164ab8",
 	Name:             "v_95",
 	Created:          &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
+	IconURL:          "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
 	PostKarma:        488,
 	CommentKarma:     22223,
 	HasVerifiedEmail: true,
@@ -198,6 +199,20 @@ func TestAccountService_UpdateSettings(t *testing.T) {
 	require.Equal(t, expectedSettings, settings)
 }
 
+func TestAccountService_NeedsCaptcha(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/needs_captcha", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `true`)
+	})
+
+	needsCaptcha, _, err := client.Account.NeedsCaptcha(ctx)
+	require.NoError(t, err)
+	require.True(t, needsCaptcha)
+}
+
 func TestAccountService_Trophies(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -322,3 +337,37 @@ func TestAccountService_RemoveTrusted(t *testing.T) {
 	_, err := client.Account.RemoveTrusted(ctx, "test123")
 	require.NoError(t, err)
 }
+
+func TestAccountService_DeleteAccount(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var called bool
+	mux.HandleFunc("/api/delete_user", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		called = true
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("user", "user1")
+		form.Set("passwd", "password1")
+		form.Set("confirm", "true")
+		form.Set("delete_message", "test reason")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Account.DeleteAccount(ctx, "password1", "test reason", false)
+	require.EqualError(t, err, "confirm: must be true to delete the account, this cannot be undone")
+	require.False(t, called)
+
+	_, err = client.Account.DeleteAccount(ctx, "", "test reason", true)
+	require.EqualError(t, err, "password: cannot be empty")
+	require.False(t, called)
+
+	_, err = client.Account.DeleteAccount(ctx, "password1", "test reason", true)
+	require.NoError(t, err)
+	require.True(t, called)
+}
@@ -0,0 +1,65 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+)
+
+// AwardService handles communication with the award
+// related methods of the Reddit API.
+// It validates the kind of thing being awarded and delegates to
+// GoldService.Gild and PostService.Award/CommentService.Award.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_gold
+type AwardService struct {
+	client *Client
+}
+
+// GiveAwardRequest represents a request to give an award to a post or comment.
+type GiveAwardRequest struct {
+	// The full ID of the post or comment, e.g. t3_abc123.
+	ThingID string
+	// The ID of the award, e.g. gid_1 for silver, gid_2 for gold, gid_3 for platinum.
+	AwardID string
+	// If true, the recipient will not know who gave the award.
+	IsAnonymous bool
+	// A private message sent to the recipient along with the award.
+	Message string
+}
+
+// GivePostAward gilds the post via its full ID.
+// This requires you to own Reddit coins and will consume them.
+// If you don't own enough, the error matches ErrInsufficientCoins.
+func (s *AwardService) GivePostAward(ctx context.Context, postID string) (*Response, error) {
+	if err := validateFullID(postID, kindPost); err != nil {
+		return nil, err
+	}
+	return s.client.Gold.Gild(ctx, postID)
+}
+
+// GiveCommentAward gilds the comment via its full ID.
+// This requires you to own Reddit coins and will consume them.
+// If you don't own enough, the error matches ErrInsufficientCoins.
+func (s *AwardService) GiveCommentAward(ctx context.Context, commentID string) (*Response, error) {
+	if err := validateFullID(commentID, kindComment); err != nil {
+		return nil, err
+	}
+	return s.client.Gold.Gild(ctx, commentID)
+}
+
+// GiveAward gives an award to a post or comment.
+// This requires you to own enough Reddit coins and will consume them.
+// If you don't, the error matches ErrInsufficientCoins.
+func (s *AwardService) GiveAward(ctx context.Context, request *GiveAwardRequest) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("request: cannot be nil")
+	}
+
+	opts := &AwardOptions{
+		Anonymous: request.IsAnonymous,
+		Message:   request.Message,
+	}
+
+	_, resp, err := s.client.Post.Award(ctx, request.ThingID, request.AwardID, opts)
+	return resp, err
+}
@@ -0,0 +1,93 @@
+package reddit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAwardService_GivePostAward(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/gold/gild/t3_test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Award.GivePostAward(ctx, "t1_test")
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	_, err = client.Award.GivePostAward(ctx, "t3_test")
+	require.NoError(t, err)
+}
+
+func TestAwardService_GiveCommentAward(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/gold/gild/t1_test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Award.GiveCommentAward(ctx, "t3_test")
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	_, err = client.Award.GiveCommentAward(ctx, "t1_test")
+	require.NoError(t, err)
+}
+
+func TestAwardService_GiveAward(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"thing_id":     "t1_test",
+			"gild_type":    "gid_2",
+			"is_anonymous": true,
+			"message":      "well said",
+		}, body)
+
+		fmt.Fprint(w, `{"coins": 1300}`)
+	})
+
+	_, err := client.Award.GiveAward(ctx, nil)
+	require.EqualError(t, err, "request: cannot be nil")
+
+	_, err = client.Award.GiveAward(ctx, &GiveAwardRequest{ThingID: "t2_test", AwardID: "gid_2"})
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	_, err = client.Award.GiveAward(ctx, &GiveAwardRequest{ThingID: "t1_test"})
+	require.EqualError(t, err, "awardID: cannot be empty")
+
+	_, err = client.Award.GiveAward(ctx, &GiveAwardRequest{
+		ThingID:     "t1_test",
+		AwardID:     "gid_2",
+		IsAnonymous: true,
+		Message:     "well said",
+	})
+	require.NoError(t, err)
+}
+
+func TestAwardService_GiveAward_InsufficientCoins(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"explanation": "You don't have enough coins.", "message": "Bad Request", "reason": "INSUFFICIENT_COINS"}`)
+	})
+
+	_, err := client.Award.GiveAward(ctx, &GiveAwardRequest{ThingID: "t3_test", AwardID: "gid_1"})
+	require.True(t, errors.Is(err, ErrInsufficientCoins))
+}
@@ -6,8 +6,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-
-	"github.com/google/go-querystring/query"
 )
 
 // CollectionService handles communication with the collection
@@ -109,7 +107,7 @@ func (s *CollectionService) Create(ctx context.Context, createRequest *Collectio
 
 	path := "api/v1/collections/create_collection"
 
-	form, err := query.Values(createRequest)
+	form, err := encodeForm(createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -12,8 +12,8 @@ import (
 
 var expectedCollection = &Collection{
 	ID:      "37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
-	Created: &Timestamp{time.Date(2020, 8, 6, 23, 25, 3, 0, time.UTC)},
-	Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 32, 0, time.UTC)},
+	Created: &Timestamp{time.Date(2020, 8, 6, 23, 25, 3, 999000000, time.UTC)},
+	Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 32, 741000000, time.UTC)},
 
 	Title:     "Test Title",
 	Permalink: "https://www.reddit.com/r/helloworldtestt/collection/37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
@@ -29,8 +29,8 @@ var expectedCollection = &Collection{
 var expectedCollections = []*Collection{
 	{
 		ID:      "37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
-		Created: &Timestamp{time.Date(2020, 8, 6, 23, 25, 3, 0, time.UTC)},
-		Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 32, 0, time.UTC)},
+		Created: &Timestamp{time.Date(2020, 8, 6, 23, 25, 3, 999000000, time.UTC)},
+		Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 32, 741000000, time.UTC)},
 
 		Title:     "Test Title",
 		Permalink: "https://www.reddit.com/r/helloworldtestt/collection/37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
@@ -43,8 +43,8 @@ var expectedCollections = []*Collection{
 	},
 	{
 		ID:      "8e94db00-6605-46c6-b0d2-44653d6f538c",
-		Created: &Timestamp{time.Date(2020, 8, 7, 0, 56, 29, 0, time.UTC)},
-		Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 27, 0, time.UTC)},
+		Created: &Timestamp{time.Date(2020, 8, 7, 0, 56, 29, 879000000, time.UTC)},
+		Updated: &Timestamp{time.Date(2020, 8, 7, 1, 59, 27, 702000000, time.UTC)},
 
 		Title:       "Test Title 2",
 		Description: "Test Description",
@@ -57,8 +57,8 @@ var expectedCollections = []*Collection{
 	},
 	{
 		ID:      "a1b3e088-f6b8-4d98-9e93-adaacef113cd",
-		Created: &Timestamp{time.Date(2020, 8, 7, 0, 55, 24, 0, time.UTC)},
-		Updated: &Timestamp{time.Date(2020, 8, 7, 0, 55, 24, 0, time.UTC)},
+		Created: &Timestamp{time.Date(2020, 8, 7, 0, 55, 24, 190000000, time.UTC)},
+		Updated: &Timestamp{time.Date(2020, 8, 7, 0, 55, 24, 190000000, time.UTC)},
 
 		Title:     "Test Title 3",
 		Permalink: "https://www.reddit.com/r/helloworldtestt/collection/a1b3e088-f6b8-4d98-9e93-adaacef113cd",
@@ -3,8 +3,8 @@ package reddit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
-	"net/url"
 	"strings"
 )
 
@@ -22,8 +22,7 @@ type CommentService struct {
 func (s *CommentService) Submit(ctx context.Context, parentID string, text string) (*Comment, *Response, error) {
 	path := "api/comment"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("return_rtjson", "true")
 	form.Set("parent", parentID)
 	form.Set("text", text)
@@ -46,8 +45,7 @@ func (s *CommentService) Submit(ctx context.Context, parentID string, text strin
 func (s *CommentService) Edit(ctx context.Context, id string, text string) (*Comment, *Response, error) {
 	path := "api/editusertext"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("return_rtjson", "true")
 	form.Set("thing_id", id)
 	form.Set("text", text)
@@ -66,6 +64,64 @@ func (s *CommentService) Edit(ctx context.Context, id string, text string) (*Com
 	return root, resp, nil
 }
 
+// Get returns a single comment in the context of its post, i.e. the post, the comment and its replies.
+// postID and commentID are ID36s, e.g. abc123, not full IDs.
+// The subreddit may be left empty if it is not known.
+func (s *CommentService) Get(ctx context.Context, subreddit, postID, commentID string) (*PostAndComments, *Response, error) {
+	if postID == "" {
+		return nil, nil, errors.New("postID: cannot be empty")
+	}
+	if commentID == "" {
+		return nil, nil, errors.New("commentID: cannot be empty")
+	}
+
+	path := fmt.Sprintf("comments/%s/_/%s", postID, commentID)
+	if subreddit != "" {
+		path = fmt.Sprintf("r/%s/%s", subreddit, path)
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PostAndComments)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetMultiple returns comments from their full IDs, in the order in which the IDs were provided.
+// Duplicate IDs are ignored, and comments that could not be found are left out.
+// Requests are made in batches of 100 IDs, which is the limit imposed by Reddit.
+func (s *CommentService) GetMultiple(ctx context.Context, ids ...string) ([]*Comment, *Response, error) {
+	if len(ids) == 0 {
+		return nil, nil, errors.New("must provide at least 1 id")
+	}
+
+	things, resp, err := s.info(ctx, ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commentMap := make(map[string]*Comment, len(things.Comments))
+	for _, comment := range things.Comments {
+		commentMap[comment.FullID] = comment
+	}
+
+	var comments []*Comment
+	for _, id := range dedupe(ids) {
+		if comment, ok := commentMap[id]; ok {
+			comments = append(comments, comment)
+		}
+	}
+
+	return comments, resp, nil
+}
+
 // LoadMoreReplies retrieves more replies that were left out when initially fetching the comment.
 func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment) (*Response, error) {
 	if comment == nil {
@@ -79,8 +135,7 @@ func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment)
 	postID := comment.PostID
 	commentIDs := comment.Replies.More.Children
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("link_id", postID)
 	form.Set("children", strings.Join(commentIDs, ","))
 
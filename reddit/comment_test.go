@@ -17,6 +17,7 @@ var expectedCommentSubmitOrEdit = &Comment{
 	Permalink: "/r/subreddit/comments/test1/some_thread/test2/",
 
 	Body:            "test comment",
+	BodyHTML:        "<div class=\"md\"><p>test comment</p>\n</div>",
 	Author:          "reddit_username",
 	AuthorID:        "t2_user1",
 	AuthorFlairText: "Flair",
@@ -32,8 +33,6 @@ var expectedCommentSubmitOrEdit = &Comment{
 	Controversiality: 0,
 
 	Created: &Timestamp{time.Date(2020, 4, 29, 0, 9, 47, 0, time.UTC)},
-	// todo: this should just be nil
-	Edited: &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	PostID: "t3_link1",
 }
@@ -66,6 +65,38 @@ func TestCommentService_Submit(t *testing.T) {
 	require.Equal(t, expectedCommentSubmitOrEdit, comment)
 }
 
+func TestCommentService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/comments/abc123/_/def456", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	mux.HandleFunc("/comments/abc123/_/def456", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Comment.Get(ctx, "test", "", "def456")
+	require.EqualError(t, err, "postID: cannot be empty")
+
+	_, _, err = client.Comment.Get(ctx, "test", "abc123", "")
+	require.EqualError(t, err, "commentID: cannot be empty")
+
+	postAndComments, _, err := client.Comment.Get(ctx, "test", "abc123", "def456")
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+
+	postAndComments, _, err = client.Comment.Get(ctx, "", "abc123", "def456")
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+}
+
 func TestCommentService_Edit(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -94,6 +125,34 @@ func TestCommentService_Edit(t *testing.T) {
 	require.Equal(t, expectedCommentSubmitOrEdit, comment)
 }
 
+func TestCommentService_GetMultiple(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/listings/posts-comments-subreddits.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t1_g05v931,t1_notfound")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Comment.GetMultiple(ctx)
+	require.EqualError(t, err, "must provide at least 1 id")
+
+	comments, _, err := client.Comment.GetMultiple(ctx, "t1_g05v931", "t1_notfound", "t1_g05v931")
+	require.NoError(t, err)
+	require.Equal(t, expectedListingComments, comments)
+}
+
 func TestCommentService_Delete(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -302,6 +361,34 @@ func TestCommentService_RemoveVote(t *testing.T) {
 	require.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func TestCommentService_Vote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var dirs []string
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t1_test", r.PostForm.Get("id"))
+		dirs = append(dirs, r.PostForm.Get("dir"))
+	})
+
+	for _, dir := range []VoteDirection{Upvote, NoVote, Downvote} {
+		_, err := client.Comment.Vote(ctx, "t1_test", dir)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"1", "0", "-1"}, dirs)
+
+	_, err := client.Comment.Vote(ctx, "t1_test", VoteDirection(2))
+	require.Equal(t, ErrInvalidVoteDirection, err)
+
+	_, err = client.Comment.Vote(ctx, "t1_test", VoteDirection(-2))
+	require.Equal(t, ErrInvalidVoteDirection, err)
+	require.Len(t, dirs, 3)
+}
+
 func TestCommentService_LoadMoreReplies(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
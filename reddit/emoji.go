@@ -7,14 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
-
-	"github.com/google/go-querystring/query"
-	"golang.org/x/net/context/ctxhttp"
 )
 
 // EmojiService handles communication with the emoji
@@ -101,6 +98,15 @@ func (s *EmojiService) Get(ctx context.Context, subreddit string) ([]*Emoji, []*
 	return defaultEmojis, subredditEmojis, resp, nil
 }
 
+// List returns the custom emojis of the subreddit, i.e. without Reddit's default ones.
+func (s *EmojiService) List(ctx context.Context, subreddit string) ([]*Emoji, *Response, error) {
+	_, subredditEmojis, resp, err := s.Get(ctx, subreddit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return subredditEmojis, resp, nil
+}
+
 // Delete deletes the emoji from the subreddit.
 func (s *EmojiService) Delete(ctx context.Context, subreddit string, emoji string) (*Response, error) {
 	path := fmt.Sprintf("api/v1/%s/emoji/%s", subreddit, emoji)
@@ -142,15 +148,12 @@ func (s *EmojiService) DisableCustomSize(ctx context.Context, subreddit string)
 	return s.client.Do(ctx, req, nil)
 }
 
-func (s *EmojiService) lease(ctx context.Context, subreddit, imagePath string) (string, map[string]string, *Response, error) {
+func (s *EmojiService) lease(ctx context.Context, subreddit, filename, mimetype string) (string, map[string]string, *Response, error) {
 	path := fmt.Sprintf("api/v1/%s/emoji_asset_upload_s3.json", subreddit)
 
 	form := url.Values{}
-	form.Set("filepath", imagePath)
-	form.Set("mimetype", "image/jpeg")
-	if strings.HasSuffix(strings.ToLower(imagePath), ".png") {
-		form.Set("mimetype", "image/png")
-	}
+	form.Set("filepath", filename)
+	form.Set("mimetype", mimetype)
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -172,7 +175,10 @@ func (s *EmojiService) lease(ctx context.Context, subreddit, imagePath string) (
 		return "", nil, resp, err
 	}
 
-	uploadURL := fmt.Sprintf("http:%s", response.S3UploadLease.Action)
+	uploadURL, err := s.client.leaseURL(response.S3UploadLease.Action)
+	if err != nil {
+		return "", nil, resp, err
+	}
 
 	fields := make(map[string]string)
 	for _, field := range response.S3UploadLease.Fields {
@@ -185,7 +191,7 @@ func (s *EmojiService) lease(ctx context.Context, subreddit, imagePath string) (
 func (s *EmojiService) upload(ctx context.Context, subreddit string, createRequest *EmojiCreateOrUpdateRequest, awsKey string) (*Response, error) {
 	path := fmt.Sprintf("api/v1/%s/emoji.json", subreddit)
 
-	form, err := query.Values(createRequest)
+	form, err := encodeForm(createRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -210,49 +216,55 @@ func (s *EmojiService) Upload(ctx context.Context, subreddit string, createReque
 		return nil, err
 	}
 
-	uploadURL, fields, resp, err := s.lease(ctx, subreddit, imagePath)
-	if err != nil {
-		return resp, err
-	}
-
 	file, err := os.Open(imagePath)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	// AWS ignores all fields in the request that come after the file field, so we need to set these before
-	// https://stackoverflow.com/questions/15234496/upload-directly-to-amazon-s3-using-ajax-returning-error-bucket-post-must-contai/15235866#15235866
-	for k, v := range fields {
-		writer.WriteField(k, v)
+	mimetype := "image/jpeg"
+	if strings.HasSuffix(strings.ToLower(imagePath), ".png") {
+		mimetype = "image/png"
 	}
 
-	part, err := writer.CreateFormFile("file", file.Name())
+	return s.uploadImage(ctx, subreddit, createRequest, file.Name(), mimetype, file)
+}
+
+// Add uploads an emoji to the subreddit from the image, which must be a PNG or JPEG.
+// The emoji can be used in both user and post flair. Use Upload for more control over its permissions.
+func (s *EmojiService) Add(ctx context.Context, subreddit string, name string, image io.Reader) (*Response, error) {
+	createRequest := &EmojiCreateOrUpdateRequest{Name: name}
+	err := createRequest.validate()
 	if err != nil {
 		return nil, err
 	}
+	if image == nil {
+		return nil, errors.New("image: cannot be nil")
+	}
 
-	_, err = io.Copy(part, file)
+	data, err := ioutil.ReadAll(image)
 	if err != nil {
 		return nil, err
 	}
 
-	err = writer.Close()
-	if err != nil {
-		return nil, err
+	mimetype := http.DetectContentType(data)
+	if mimetype != "image/png" && mimetype != "image/jpeg" {
+		return nil, fmt.Errorf("image: must be a PNG or JPEG, got %s", mimetype)
 	}
 
-	httpResponse, err := ctxhttp.Post(ctx, nil, uploadURL, writer.FormDataContentType(), body)
+	return s.uploadImage(ctx, subreddit, createRequest, name, mimetype, bytes.NewReader(data))
+}
+
+// uploadImage obtains an upload lease, uploads the image to it, and then registers the emoji in the subreddit.
+func (s *EmojiService) uploadImage(ctx context.Context, subreddit string, createRequest *EmojiCreateOrUpdateRequest, filename, mimetype string, image io.Reader) (*Response, error) {
+	uploadURL, fields, resp, err := s.lease(ctx, subreddit, filename, mimetype)
 	if err != nil {
-		return nil, err
+		return resp, err
 	}
 
-	err = CheckResponse(httpResponse)
+	resp, err = s.client.uploadToLease(ctx, uploadURL, fields, filename, image)
 	if err != nil {
-		return &Response{httpResponse}, err
+		return resp, err
 	}
 
 	return s.upload(ctx, subreddit, createRequest, fields["key"])
@@ -271,7 +283,7 @@ func (s *EmojiService) Update(ctx context.Context, subreddit string, updateReque
 
 	path := fmt.Sprintf("api/v1/%s/emoji_permissions", subreddit)
 
-	form, err := query.Values(updateRequest)
+	form, err := encodeForm(updateRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -63,6 +64,23 @@ func TestEmojiService_Get(t *testing.T) {
 	require.Equal(t, expectedSubredditEmojis, subredditEmojis)
 }
 
+func TestEmojiService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/emoji/emojis.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/test/emojis/all", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	emojis, _, err := client.Emoji.List(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, expectedSubredditEmojis, emojis)
+}
+
 func TestEmojiService_Delete(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -201,6 +219,72 @@ func TestEmojiService_Upload(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEmojiService_Add(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	uploadURL := client.BaseURL.Host + "/api/emoji_upload"
+
+	blob, err := readFileContents("../testdata/emoji/lease.json")
+	require.NoError(t, err)
+	blob = fmt.Sprintf(blob, uploadURL)
+
+	image := "\x89PNG\r\n\x1a\nthis is a test"
+
+	mux.HandleFunc("/api/v1/testsubreddit/emoji_asset_upload_s3.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("filepath", "testemoji")
+		form.Set("mimetype", "image/png")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	mux.HandleFunc("/api/emoji_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		_, file, err := r.FormFile("file")
+		require.NoError(t, err)
+
+		rdr, err := file.Open()
+		require.NoError(t, err)
+
+		buf := new(bytes.Buffer)
+		_, err = io.Copy(buf, rdr)
+		require.NoError(t, err)
+		require.Equal(t, image, buf.String())
+	})
+
+	mux.HandleFunc("/api/v1/testsubreddit/emoji.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("name", "testemoji")
+		form.Set("s3_key", "t5_2uquw1/t2_164ab8/a94a8f45ccb199a61c4c0873d391e98c982fabd3")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+	})
+
+	_, err = client.Emoji.Add(ctx, "testsubreddit", "", strings.NewReader(image))
+	require.EqualError(t, err, "name: cannot be empty")
+
+	_, err = client.Emoji.Add(ctx, "testsubreddit", "testemoji", nil)
+	require.EqualError(t, err, "image: cannot be nil")
+
+	_, err = client.Emoji.Add(ctx, "testsubreddit", "testemoji", strings.NewReader("not an image"))
+	require.EqualError(t, err, "image: must be a PNG or JPEG, got text/plain; charset=utf-8")
+
+	_, err = client.Emoji.Add(ctx, "testsubreddit", "testemoji", strings.NewReader(image))
+	require.NoError(t, err)
+}
+
 func TestEmojiService_Update(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -2,10 +2,83 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+var (
+	// ErrInvalidFullID is returned when a full ID does not have the kind prefix expected by an endpoint.
+	ErrInvalidFullID = errors.New("invalid full id")
+	// ErrInvalidPermalink is returned when a link does not point to a post or comment on Reddit.
+	ErrInvalidPermalink = errors.New("invalid permalink")
+	// ErrInvalidDuration is returned when a ban's duration is outside the range accepted by Reddit.
+	ErrInvalidDuration = errors.New("duration: must be between 1 and 999 days (inclusive)")
+	// ErrSubredditNotFound is returned when the subreddit of a request does not exist.
+	ErrSubredditNotFound = errors.New("subreddit not found")
+	// ErrUserNotFound is returned when the user of a request does not exist.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidUsername is returned when a username is not 3 to 20 letters, numbers, underscores or hyphens.
+	ErrInvalidUsername = errors.New("username: must be 3 to 20 letters, numbers, underscores or hyphens")
+	// ErrInvalidVoteDirection is returned when a vote's direction is not one of Downvote, NoVote or Upvote.
+	ErrInvalidVoteDirection = errors.New("invalid vote direction")
+	// ErrEmptyQuery is returned when a search is attempted with a blank query.
+	ErrEmptyQuery = errors.New("query: cannot be empty")
+	// ErrStylesheetTooLong is returned when a stylesheet is longer than the 100,000 bytes allowed by Reddit.
+	ErrStylesheetTooLong = errors.New("stylesheet: cannot be longer than 100000 bytes")
+	// ErrGoldRequired is returned when an action is only available to users with Reddit Gold (Premium).
+	ErrGoldRequired = errors.New("reddit gold required")
+	// ErrReadOnly is returned when a client created with WithReadOnly attempts a request that isn't a GET.
+	ErrReadOnly = errors.New("client is read-only")
+	// ErrIteratorDone is returned by an Iterator when there are no more items.
+	ErrIteratorDone = errors.New("iterator: no more items")
+
+	// The following errors are not returned directly, but errors returned by the client
+	// match them with errors.Is when Reddit responds with the corresponding status code or error.
+
+	// ErrUnauthorized matches errors caused by a 401 Unauthorized response.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches errors caused by a 403 Forbidden response.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound matches errors caused by a 404 Not Found response.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited matches errors caused by a 429 Too Many Requests response, or a RATELIMIT error.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrBadCaptcha matches BAD_CAPTCHA errors, returned when an action requires a captcha
+	// that was missing or wrong. See AccountService.NeedsCaptcha.
+	ErrBadCaptcha = errors.New("bad captcha")
+	// ErrInsufficientCoins matches errors caused by giving an award without owning enough Reddit coins.
+	ErrInsufficientCoins = errors.New("insufficient coins")
+)
+
+// statusErrors maps HTTP status codes to the errors they match.
+var statusErrors = map[int]error{
+	http.StatusUnauthorized:    ErrUnauthorized,
+	http.StatusForbidden:       ErrForbidden,
+	http.StatusNotFound:        ErrNotFound,
+	http.StatusTooManyRequests: ErrRateLimited,
+}
+
+// labelErrors maps the labels of API errors to the errors they match.
+var labelErrors = map[string]error{
+	"SUBREDDIT_NOEXIST": ErrSubredditNotFound,
+	"USER_DOESNT_EXIST": ErrUserNotFound,
+	"RATELIMIT":         ErrRateLimited,
+	"BAD_CAPTCHA":       ErrBadCaptcha,
+
+	"INSUFFICIENT_COINS":             ErrInsufficientCoins,
+	"INSUFFICIENT_COINS_WITH_AMOUNT": ErrInsufficientCoins,
+}
+
+// isStatusError reports whether the response's status code corresponds to target.
+func isStatusError(r *http.Response, target error) bool {
+	if r == nil {
+		return false
+	}
+	err, ok := statusErrors[r.StatusCode]
+	return ok && err == target
+}
+
 // APIError is an error coming from Reddit.
 type APIError struct {
 	Label  string
@@ -17,6 +90,13 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("field %q caused %s: %s", e.Field, e.Label, e.Reason)
 }
 
+// Is reports whether the error's label corresponds to target,
+// e.g. an error labelled SUBREDDIT_NOEXIST matches ErrSubredditNotFound.
+func (e *APIError) Is(target error) bool {
+	err, ok := labelErrors[e.Label]
+	return ok && err == target
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (e *APIError) UnmarshalJSON(data []byte) error {
 	var info [3]string
@@ -55,6 +135,27 @@ func (r *JSONErrorResponse) Error() string {
 	)
 }
 
+// Is reports whether the response's status code or any of its API errors correspond to target.
+func (r *JSONErrorResponse) Is(target error) bool {
+	if isStatusError(r.Response, target) {
+		return true
+	}
+	for i := range r.JSON.Errors {
+		if r.JSON.Errors[i].Is(target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the first API error, so it can be obtained with errors.As.
+func (r *JSONErrorResponse) Unwrap() error {
+	if len(r.JSON.Errors) == 0 {
+		return nil
+	}
+	return &r.JSON.Errors[0]
+}
+
 // An ErrorResponse reports the error caused by an API request
 type ErrorResponse struct {
 	// HTTP response that caused this error
@@ -62,6 +163,8 @@ type ErrorResponse struct {
 
 	// Error message
 	Message string `json:"message"`
+	// Label of the error, e.g. INSUFFICIENT_COINS. Only sent by some endpoints.
+	Reason string `json:"reason,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -71,4 +174,14 @@ func (r *ErrorResponse) Error() string {
 	)
 }
 
+// Is reports whether the response's status code or reason corresponds to target,
+// e.g. a 404 Not Found response matches ErrNotFound.
+func (r *ErrorResponse) Is(target error) bool {
+	if isStatusError(r.Response, target) {
+		return true
+	}
+	err, ok := labelErrors[r.Reason]
+	return ok && err == target
+}
+
 // todo: rate limit errors
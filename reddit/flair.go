@@ -1,9 +1,14 @@
 package reddit
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // FlairService handles communication with the flair
@@ -26,6 +31,105 @@ type Flair struct {
 
 	Editable bool `json:"text_editable"`
 	ModOnly  bool `json:"mod_only"`
+
+	// One of: all, emoji, text.
+	AllowableContent string `json:"allowable_content,omitempty"`
+	MaxEmojis        int    `json:"max_emojis,omitempty"`
+}
+
+// FlairTemplateType is the kind of thing a flair template applies to.
+type FlairTemplateType string
+
+// Possible flair template types.
+const (
+	FlairTemplateTypeUser FlairTemplateType = "USER_FLAIR"
+	FlairTemplateTypePost FlairTemplateType = "LINK_FLAIR"
+)
+
+// FlairTemplateCreateOrUpdateRequest represents a request to create or update a flair template.
+type FlairTemplateCreateOrUpdateRequest struct {
+	// The ID of the template. Required when updating, ignored when creating.
+	ID   string            `url:"flair_template_id,omitempty"`
+	Type FlairTemplateType `url:"flair_type"`
+	Text string            `url:"text"`
+
+	CSSClass string `url:"css_class,omitempty"`
+	// One of: light, dark.
+	TextColor       string `url:"text_color,omitempty"`
+	BackgroundColor string `url:"background_color,omitempty"`
+
+	ModOnly      bool `url:"mod_only"`
+	TextEditable bool `url:"text_editable"`
+
+	// One of: all, emoji, text.
+	AllowableContent string `url:"allowable_content,omitempty"`
+	// Between 1 and 10 (inclusive).
+	MaxEmojis int `url:"max_emojis,omitempty"`
+}
+
+func (r *FlairTemplateCreateOrUpdateRequest) validate() error {
+	if r.Type != FlairTemplateTypeUser && r.Type != FlairTemplateTypePost {
+		return fmt.Errorf("type: must be one of %s, %s", FlairTemplateTypeUser, FlairTemplateTypePost)
+	}
+	if r.Text == "" && r.CSSClass == "" {
+		return errors.New("text: cannot be empty if css class is empty")
+	}
+	return nil
+}
+
+// FlairSelector holds the flair of the current user in a subreddit,
+// along with the flairs they are allowed to choose from.
+type FlairSelector struct {
+	// Nil if the user does not have a flair in the subreddit.
+	Current *Flair   `json:"current,omitempty"`
+	Choices []*Flair `json:"choices,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (f *FlairSelector) UnmarshalJSON(data []byte) error {
+	// The flair selector uses different keys than the flair template endpoints.
+	type selectorFlair struct {
+		ID               string `json:"flair_template_id"`
+		Text             string `json:"flair_text"`
+		CSSClass         string `json:"flair_css_class"`
+		Color            string `json:"flair_text_color"`
+		BackgroundColor  string `json:"flair_background_color"`
+		Editable         bool   `json:"flair_text_editable"`
+		ModOnly          bool   `json:"mod_only"`
+		AllowableContent string `json:"allowable_content"`
+	}
+	toFlair := func(sf *selectorFlair) *Flair {
+		return &Flair{
+			ID:               sf.ID,
+			Text:             sf.Text,
+			CSSClass:         sf.CSSClass,
+			Color:            sf.Color,
+			BackgroundColor:  sf.BackgroundColor,
+			Editable:         sf.Editable,
+			ModOnly:          sf.ModOnly,
+			AllowableContent: sf.AllowableContent,
+		}
+	}
+
+	root := new(struct {
+		Current *selectorFlair   `json:"current"`
+		Choices []*selectorFlair `json:"choices"`
+	})
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	f.Current = nil
+	if root.Current != nil && *root.Current != (selectorFlair{}) {
+		f.Current = toFlair(root.Current)
+	}
+	f.Choices = nil
+	for _, choice := range root.Choices {
+		f.Choices = append(f.Choices, toFlair(choice))
+	}
+
+	return nil
 }
 
 // FlairSummary is a condensed version of Flair.
@@ -35,6 +139,25 @@ type FlairSummary struct {
 	CSSClass string `json:"flair_css_class,omitempty"`
 }
 
+// maxFlairCSVRows is the maximum number of rows Reddit accepts in a single flaircsv request.
+const maxFlairCSVRows = 100
+
+// FlairCSVRow is the flair of a user, used to set flairs in bulk.
+// If both Text and CSSClass are empty, the user's flair is removed.
+type FlairCSVRow struct {
+	User     string
+	Text     string
+	CSSClass string
+}
+
+// FlairCSVResult is the outcome of setting the flair of a single row.
+type FlairCSVResult struct {
+	OK       bool              `json:"ok"`
+	Status   string            `json:"status,omitempty"`
+	Warnings map[string]string `json:"warnings,omitempty"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
 // GetUserFlairs returns the user flairs from the subreddit.
 func (s *FlairService) GetUserFlairs(ctx context.Context, subreddit string) ([]*Flair, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/user_flair_v2", subreddit)
@@ -90,3 +213,220 @@ func (s *FlairService) ListUserFlairs(ctx context.Context, subreddit string) ([]
 
 	return root.UserFlairs, resp, nil
 }
+
+// Current returns the flair of the current user in the subreddit,
+// along with the user flairs they can choose from.
+func (s *FlairService) Current(ctx context.Context, subreddit string) (*FlairSelector, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	return s.selector(ctx, subreddit, nil)
+}
+
+// selector returns the current flair and flair choices for the subreddit.
+// The form determines what the flairs are for, e.g. is_newlink for a post about to be submitted.
+func (s *FlairService) selector(ctx context.Context, subreddit string, form url.Values) (*FlairSelector, *Response, error) {
+	path := fmt.Sprintf("r/%s/api/flairselector", subreddit)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(FlairSelector)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// AssignUserFlair sets the flair of a user in the subreddit.
+// This requires the user to be a moderator of the subreddit.
+func (s *FlairService) AssignUserFlair(ctx context.Context, subreddit, username, text, cssClass string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if username == "" {
+		return nil, errors.New("username: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/flair", subreddit)
+
+	form := newJSONForm()
+	form.Set("name", username)
+	form.Set("text", text)
+	form.Set("css_class", cssClass)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteUserFlair removes the flair of a user in the subreddit.
+// This requires the user to be a moderator of the subreddit.
+func (s *FlairService) DeleteUserFlair(ctx context.Context, subreddit, username string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if username == "" {
+		return nil, errors.New("username: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/deleteflair", subreddit)
+
+	form := newJSONForm()
+	form.Set("name", username)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ImportCSV sets the flairs of users in the subreddit in bulk.
+// The results are returned in the same order as the rows.
+// Requests are made in batches of 100 rows, which is the limit imposed by Reddit.
+func (s *FlairService) ImportCSV(ctx context.Context, subreddit string, rows []FlairCSVRow) ([]*FlairCSVResult, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+	if len(rows) == 0 {
+		return nil, nil, errors.New("must provide at least 1 row")
+	}
+
+	path := fmt.Sprintf("r/%s/api/flaircsv", subreddit)
+
+	var results []*FlairCSVResult
+	var resp *Response
+	for start := 0; start < len(rows); start += maxFlairCSVRows {
+		end := start + maxFlairCSVRows
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		flairCSV, err := encodeFlairCSV(rows[start:end])
+		if err != nil {
+			return nil, resp, err
+		}
+
+		form := url.Values{}
+		form.Set("flair_csv", flairCSV)
+
+		req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		var root []*FlairCSVResult
+		resp, err = s.client.Do(ctx, req, &root)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		results = append(results, root...)
+	}
+
+	return results, resp, nil
+}
+
+func encodeFlairCSV(rows []FlairCSVRow) (string, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	for _, row := range rows {
+		if row.User == "" {
+			return "", errors.New("user: cannot be empty")
+		}
+		if err := w.Write([]string{row.User, row.Text, row.CSSClass}); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// CreateTemplate creates a user or post flair template in the subreddit.
+func (s *FlairService) CreateTemplate(ctx context.Context, subreddit string, createRequest *FlairTemplateCreateOrUpdateRequest) (*Flair, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("createRequest: cannot be nil")
+	}
+
+	// the endpoint updates the template if an ID is sent
+	r := *createRequest
+	r.ID = ""
+
+	return s.createOrUpdateTemplate(ctx, subreddit, &r)
+}
+
+// UpdateTemplate updates a user or post flair template in the subreddit.
+// The ID of the template must be set.
+func (s *FlairService) UpdateTemplate(ctx context.Context, subreddit string, updateRequest *FlairTemplateCreateOrUpdateRequest) (*Flair, *Response, error) {
+	if updateRequest == nil {
+		return nil, nil, errors.New("updateRequest: cannot be nil")
+	}
+	if updateRequest.ID == "" {
+		return nil, nil, errors.New("id: cannot be empty")
+	}
+
+	return s.createOrUpdateTemplate(ctx, subreddit, updateRequest)
+}
+
+func (s *FlairService) createOrUpdateTemplate(ctx context.Context, subreddit string, request *FlairTemplateCreateOrUpdateRequest) (*Flair, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	err := request.validate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("r/%s/api/flairtemplate_v2", subreddit)
+
+	form, err := encodeJSONForm(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Flair)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteTemplate deletes a user or post flair template from the subreddit.
+func (s *FlairService) DeleteTemplate(ctx context.Context, subreddit, id string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if id == "" {
+		return nil, errors.New("id: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/deleteflairtemplate", subreddit)
+
+	form := newJSONForm()
+	form.Set("flair_template_id", id)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -1,8 +1,11 @@
 package reddit
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,6 +23,9 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  false,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
 	},
 	{
 		ID:   "b8ea0fce-3feb-11e8-af7a-0e263a127cf8",
@@ -32,6 +38,9 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
 	},
 }
 
@@ -47,6 +56,9 @@ var expectedPostFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
 	},
 }
 
@@ -111,3 +123,296 @@ func TestFlairService_ListUserFlairs(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedListUserFlairs, userFlairs)
 }
+
+func TestFlairService_Current(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/flair/selector.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairselector", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Flair.Current(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	selector, _, err := client.Flair.Current(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, &FlairSelector{
+		Current: &Flair{ID: "b8a1c822-3feb-11e8-88e1-0e5f55d58ce0", Text: "Beginner", CSSClass: "Beginner1"},
+		Choices: []*Flair{
+			{ID: "b8a1c822-3feb-11e8-88e1-0e5f55d58ce0", Text: "Beginner", CSSClass: "Beginner1"},
+			{ID: "c7a2d9f0-3feb-11e8-8a4c-0e1c6e9f1d2a", Text: "Custom", Editable: true},
+		},
+	}, selector)
+}
+
+func TestFlairSelector_UnmarshalJSON_NoCurrent(t *testing.T) {
+	selector := new(FlairSelector)
+	err := json.Unmarshal([]byte(`{"current": {}, "choices": []}`), selector)
+	require.NoError(t, err)
+	require.Equal(t, &FlairSelector{}, selector)
+}
+
+func TestFlairService_AssignUserFlair(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/flair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("text", "Beginner")
+		form.Set("css_class", "Beginner1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Flair.AssignUserFlair(ctx, "", "testuser", "Beginner", "Beginner1")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Flair.AssignUserFlair(ctx, "testsubreddit", "", "Beginner", "Beginner1")
+	require.EqualError(t, err, "username: cannot be empty")
+
+	_, err = client.Flair.AssignUserFlair(ctx, "testsubreddit", "testuser", "Beginner", "Beginner1")
+	require.NoError(t, err)
+}
+
+func TestFlairService_DeleteUserFlair(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/deleteflair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Flair.DeleteUserFlair(ctx, "", "testuser")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Flair.DeleteUserFlair(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "username: cannot be empty")
+
+	_, err = client.Flair.DeleteUserFlair(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
+func TestFlairService_ImportCSV(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/flair/import-csv.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("flair_csv", "testuser1,Beginner,Beginner1\ntestuser2,\"Mod, Senior\",\n")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Flair.ImportCSV(ctx, "", []FlairCSVRow{{User: "testuser1"}})
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Flair.ImportCSV(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "must provide at least 1 row")
+
+	_, _, err = client.Flair.ImportCSV(ctx, "testsubreddit", []FlairCSVRow{{Text: "Beginner"}})
+	require.EqualError(t, err, "user: cannot be empty")
+
+	results, _, err := client.Flair.ImportCSV(ctx, "testsubreddit", []FlairCSVRow{
+		{User: "testuser1", Text: "Beginner", CSSClass: "Beginner1"},
+		{User: "testuser2", Text: "Mod, Senior"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*FlairCSVResult{
+		{
+			OK:       true,
+			Status:   "added flair for user testuser1",
+			Warnings: map[string]string{},
+			Errors:   map[string]string{},
+		},
+		{
+			OK:       false,
+			Status:   "skipped",
+			Warnings: map[string]string{},
+			Errors:   map[string]string{"user": "unable to resolve user `testuser2', ignoring"},
+		},
+	}, results)
+}
+
+func TestFlairService_ImportCSV_Batches(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var batches []int
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		n := strings.Count(r.PostForm.Get("flair_csv"), "\n")
+		batches = append(batches, n)
+
+		results := make([]*FlairCSVResult, n)
+		for i := range results {
+			results[i] = &FlairCSVResult{OK: true}
+		}
+		err = json.NewEncoder(w).Encode(results)
+		require.NoError(t, err)
+	})
+
+	rows := make([]FlairCSVRow, 250)
+	for i := range rows {
+		rows[i] = FlairCSVRow{User: fmt.Sprintf("testuser%d", i), Text: "test"}
+	}
+
+	results, _, err := client.Flair.ImportCSV(ctx, "testsubreddit", rows)
+	require.NoError(t, err)
+	require.Len(t, results, 250)
+	require.Equal(t, []int{100, 100, 50}, batches)
+}
+
+func TestFlairService_CreateTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/flair/template.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairtemplate_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("flair_type", "LINK_FLAIR")
+		form.Set("text", "test")
+		form.Set("css_class", "test")
+		form.Set("text_color", "light")
+		form.Set("background_color", "#373c3f")
+		form.Set("mod_only", "true")
+		form.Set("text_editable", "false")
+		form.Set("allowable_content", "all")
+		form.Set("max_emojis", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Flair.CreateTemplate(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "createRequest: cannot be nil")
+
+	_, _, err = client.Flair.CreateTemplate(ctx, "", &FlairTemplateCreateOrUpdateRequest{Type: FlairTemplateTypePost, Text: "test"})
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Flair.CreateTemplate(ctx, "testsubreddit", &FlairTemplateCreateOrUpdateRequest{Text: "test"})
+	require.EqualError(t, err, "type: must be one of USER_FLAIR, LINK_FLAIR")
+
+	_, _, err = client.Flair.CreateTemplate(ctx, "testsubreddit", &FlairTemplateCreateOrUpdateRequest{Type: FlairTemplateTypePost})
+	require.EqualError(t, err, "text: cannot be empty if css class is empty")
+
+	flair, _, err := client.Flair.CreateTemplate(ctx, "testsubreddit", &FlairTemplateCreateOrUpdateRequest{
+		// ignored when creating
+		ID:               "305b503e-da60-11ea-9681-0e9f1d580d2d",
+		Type:             FlairTemplateTypePost,
+		Text:             "test",
+		CSSClass:         "test",
+		TextColor:        "light",
+		BackgroundColor:  "#373c3f",
+		ModOnly:          true,
+		AllowableContent: "all",
+		MaxEmojis:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPostFlairs[0], flair)
+}
+
+func TestFlairService_UpdateTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/flair/template.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairtemplate_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("flair_template_id", "305b503e-da60-11ea-9681-0e9f1d580d2d")
+		form.Set("flair_type", "LINK_FLAIR")
+		form.Set("text", "test")
+		form.Set("mod_only", "false")
+		form.Set("text_editable", "false")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Flair.UpdateTemplate(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "updateRequest: cannot be nil")
+
+	_, _, err = client.Flair.UpdateTemplate(ctx, "testsubreddit", &FlairTemplateCreateOrUpdateRequest{Type: FlairTemplateTypePost, Text: "test"})
+	require.EqualError(t, err, "id: cannot be empty")
+
+	flair, _, err := client.Flair.UpdateTemplate(ctx, "testsubreddit", &FlairTemplateCreateOrUpdateRequest{
+		ID:   "305b503e-da60-11ea-9681-0e9f1d580d2d",
+		Type: FlairTemplateTypePost,
+		Text: "test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPostFlairs[0], flair)
+}
+
+func TestFlairService_DeleteTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/deleteflairtemplate", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("flair_template_id", "305b503e-da60-11ea-9681-0e9f1d580d2d")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Flair.DeleteTemplate(ctx, "", "305b503e-da60-11ea-9681-0e9f1d580d2d")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Flair.DeleteTemplate(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "id: cannot be empty")
+
+	_, err = client.Flair.DeleteTemplate(ctx, "testsubreddit", "305b503e-da60-11ea-9681-0e9f1d580d2d")
+	require.NoError(t, err)
+}
@@ -0,0 +1,54 @@
+package reddit
+
+import "strings"
+
+// Kinds of things on Reddit, used as the prefix of a full ID, e.g. t3_abc123.
+const (
+	KindComment   = "t1"
+	KindAccount   = "t2"
+	KindPost      = "t3"
+	KindMessage   = "t4"
+	KindSubreddit = "t5"
+	KindAward     = "t6"
+)
+
+// FullID returns the full ID of the thing, made from its kind and ID36,
+// e.g. FullID(KindPost, "abc123") returns "t3_abc123".
+// If the ID is already prefixed by the kind, it is returned as is.
+func FullID(kind, id string) string {
+	if strings.HasPrefix(id, kind+"_") {
+		return id
+	}
+	return kind + "_" + id
+}
+
+// SplitID splits the full ID into its kind and ID36,
+// e.g. SplitID("t3_abc123") returns "t3" and "abc123".
+// If the full ID is malformed, both are empty.
+func SplitID(fullID string) (kind, id string) {
+	kind, id, ok := strings.Cut(fullID, "_")
+	if !ok || !isKind(kind) || id == "" {
+		return "", ""
+	}
+	return kind, id
+}
+
+// KindOf returns the kind of the full ID, e.g. "t3" for "t3_abc123".
+// If the full ID is malformed, it is empty.
+func KindOf(fullID string) string {
+	kind, _ := SplitID(fullID)
+	return kind
+}
+
+// isKind reports whether s looks like a kind prefix, i.e. a "t" followed by digits.
+func isKind(s string) bool {
+	if len(s) < 2 || s[0] != 't' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package reddit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullID(t *testing.T) {
+	require.Equal(t, "t3_abc123", FullID(KindPost, "abc123"))
+	require.Equal(t, "t3_abc123", FullID(KindPost, "t3_abc123"))
+	require.Equal(t, "t1_t3_abc123", FullID(KindComment, "t3_abc123"))
+	require.Equal(t, "t5_2qh1i", FullID(KindSubreddit, "2qh1i"))
+}
+
+func TestSplitID(t *testing.T) {
+	kind, id := SplitID("t3_abc123")
+	require.Equal(t, KindPost, kind)
+	require.Equal(t, "abc123", id)
+
+	kind, id = SplitID("t1_def456")
+	require.Equal(t, KindComment, kind)
+	require.Equal(t, "def456", id)
+
+	for _, fullID := range []string{
+		"",
+		"abc123",
+		"_abc123",
+		"t3_",
+		"t_abc123",
+		"x3_abc123",
+		"t3x_abc123",
+		"T3_abc123",
+	} {
+		kind, id := SplitID(fullID)
+		require.Empty(t, kind, fullID)
+		require.Empty(t, id, fullID)
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	require.Equal(t, KindPost, KindOf("t3_abc123"))
+	require.Equal(t, KindSubreddit, KindOf("t5_2qh1i"))
+	require.Empty(t, KindOf("abc123"))
+	require.Empty(t, KindOf("t3_"))
+}
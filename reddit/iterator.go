@@ -0,0 +1,144 @@
+package reddit
+
+import (
+	"context"
+)
+
+// IteratorOptions are the optional parameters used to limit an iterator.
+type IteratorOptions struct {
+	// Maximum number of items to return. 0 means there is no limit.
+	MaxItems int
+	// Maximum number of pages to fetch. 0 means there is no limit.
+	MaxPages int
+}
+
+// Iterator goes through every item of a paginated listing, fetching the next page when the
+// current one is exhausted. It stops when Reddit returns no after cursor, or when one of
+// the limits in its IteratorOptions is reached.
+//
+//	it := reddit.PostIterator(func(ctx context.Context, after string) (*reddit.Posts, *reddit.Response, error) {
+//		return client.Subreddit.NewPosts(ctx, "golang", &reddit.ListOptions{Limit: 100, After: after})
+//	}, nil)
+//	for !it.Done() {
+//		post, err := it.Next(ctx)
+//		if errors.Is(err, reddit.ErrIteratorDone) {
+//			break
+//		}
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch func(ctx context.Context, after string) ([]T, string, error)
+	opts  IteratorOptions
+
+	items []T
+	after string
+
+	started bool
+	last    bool
+	pages   int
+	count   int
+}
+
+// NewIterator returns an iterator over the items returned by fetch.
+// fetch must return a page of items given the after cursor, along with the cursor of the next page.
+func NewIterator[T any](fetch func(ctx context.Context, after string) ([]T, string, error), opts *IteratorOptions) *Iterator[T] {
+	it := &Iterator[T]{fetch: fetch}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// Done reports whether the iterator is known to have no more items.
+// Next may still return ErrIteratorDone if the next page turns out to be empty.
+func (it *Iterator[T]) Done() bool {
+	if it.opts.MaxItems > 0 && it.count >= it.opts.MaxItems {
+		return true
+	}
+	return len(it.items) == 0 && it.noMorePages()
+}
+
+// Next returns the next item, fetching the next page if needed.
+// It returns ErrIteratorDone when there are no more items.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+
+	for len(it.items) == 0 {
+		if it.Done() {
+			return zero, ErrIteratorDone
+		}
+
+		items, after, err := it.fetch(ctx, it.after)
+		if err != nil {
+			return zero, err
+		}
+
+		// guard against Reddit sending back the same cursor, which would loop forever
+		it.last = after == "" || after == it.after
+		it.started = true
+		it.pages++
+		it.items = items
+		it.after = after
+	}
+
+	if it.Done() {
+		return zero, ErrIteratorDone
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+	it.count++
+
+	return item, nil
+}
+
+func (it *Iterator[T]) noMorePages() bool {
+	if !it.started {
+		return false
+	}
+	return it.last || (it.opts.MaxPages > 0 && it.pages >= it.opts.MaxPages)
+}
+
+// PostIterator returns an iterator over the posts returned by fetch.
+func PostIterator(fetch func(ctx context.Context, after string) (*Posts, *Response, error), opts *IteratorOptions) *Iterator[*Post] {
+	return NewIterator(func(ctx context.Context, after string) ([]*Post, string, error) {
+		posts, _, err := fetch(ctx, after)
+		if err != nil || posts == nil {
+			return nil, "", err
+		}
+		return posts.Posts, posts.After, nil
+	}, opts)
+}
+
+// CommentIterator returns an iterator over the comments returned by fetch.
+func CommentIterator(fetch func(ctx context.Context, after string) (*Comments, *Response, error), opts *IteratorOptions) *Iterator[*Comment] {
+	return NewIterator(func(ctx context.Context, after string) ([]*Comment, string, error) {
+		comments, _, err := fetch(ctx, after)
+		if err != nil || comments == nil {
+			return nil, "", err
+		}
+		return comments.Comments, comments.After, nil
+	}, opts)
+}
+
+// SubredditIterator returns an iterator over the subreddits returned by fetch.
+func SubredditIterator(fetch func(ctx context.Context, after string) (*Subreddits, *Response, error), opts *IteratorOptions) *Iterator[*Subreddit] {
+	return NewIterator(func(ctx context.Context, after string) ([]*Subreddit, string, error) {
+		subreddits, _, err := fetch(ctx, after)
+		if err != nil || subreddits == nil {
+			return nil, "", err
+		}
+		return subreddits.Subreddits, subreddits.After, nil
+	}, opts)
+}
+
+// MessageIterator returns an iterator over the messages returned by fetch.
+func MessageIterator(fetch func(ctx context.Context, after string) (*Messages, *Response, error), opts *IteratorOptions) *Iterator[*Message] {
+	return NewIterator(func(ctx context.Context, after string) ([]*Message, string, error) {
+		messages, _, err := fetch(ctx, after)
+		if err != nil || messages == nil {
+			return nil, "", err
+		}
+		return messages.Messages, messages.After, nil
+	}, opts)
+}
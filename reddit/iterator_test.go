@@ -0,0 +1,115 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostIterator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	pages := map[string]string{
+		"":       `{"kind": "Listing", "data": {"after": "t3_p2", "children": [{"kind": "t3", "data": {"id": "p1", "name": "t3_p1"}}, {"kind": "t3", "data": {"id": "p2", "name": "t3_p2"}}]}}`,
+		"t3_p2":  `{"kind": "Listing", "data": {"after": "t3_p3", "children": [{"kind": "t3", "data": {"id": "p3", "name": "t3_p3"}}]}}`,
+		"t3_p3":  `{"kind": "Listing", "data": {"after": null, "children": []}}`,
+		"t3_bad": `{"kind": "Listing", "data": {"after": "t3_bad", "children": []}}`,
+	}
+
+	var calls int
+	mux.HandleFunc("/r/test/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		calls++
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		page, ok := pages[r.Form.Get("after")]
+		require.True(t, ok)
+		fmt.Fprint(w, page)
+	})
+
+	fetch := func(ctx context.Context, after string) (*Posts, *Response, error) {
+		return client.Subreddit.NewPosts(ctx, "test", &ListOptions{After: after})
+	}
+
+	it := PostIterator(fetch, nil)
+
+	var ids []string
+	for !it.Done() {
+		post, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			break
+		}
+		require.NoError(t, err)
+		ids = append(ids, post.ID)
+	}
+	require.Equal(t, []string{"p1", "p2", "p3"}, ids)
+	require.Equal(t, 3, calls)
+	require.True(t, it.Done())
+
+	_, err := it.Next(ctx)
+	require.Equal(t, ErrIteratorDone, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestPostIterator_Limits(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/test/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"after": "t3_p2", "children": [{"kind": "t3", "data": {"id": "p1", "name": "t3_p1"}}, {"kind": "t3", "data": {"id": "p2", "name": "t3_p2"}}]}}`)
+	})
+
+	fetch := func(ctx context.Context, after string) (*Posts, *Response, error) {
+		return client.Subreddit.NewPosts(ctx, "test", &ListOptions{After: after})
+	}
+
+	// the same cursor is returned every time, which must not loop forever
+	it := PostIterator(fetch, nil)
+	var count int
+	for !it.Done() {
+		_, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			break
+		}
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 4, count)
+
+	it = PostIterator(fetch, &IteratorOptions{MaxItems: 3})
+	count = 0
+	for !it.Done() {
+		_, err := it.Next(ctx)
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 3, count)
+
+	it = PostIterator(fetch, &IteratorOptions{MaxPages: 1})
+	count = 0
+	for !it.Done() {
+		_, err := it.Next(ctx)
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 2, count)
+}
+
+func TestIterator_Error(t *testing.T) {
+	fetchErr := errors.New("fetch error")
+	it := MessageIterator(func(ctx context.Context, after string) (*Messages, *Response, error) {
+		return nil, nil, fetchErr
+	}, nil)
+
+	require.False(t, it.Done())
+	_, err := it.Next(ctx)
+	require.Equal(t, fetchErr, err)
+}
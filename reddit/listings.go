@@ -55,3 +55,43 @@ func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post,
 	posts := root.getPosts().Posts
 	return posts, resp, nil
 }
+
+// Best returns the posts of your personalized front page, i.e. the best posts from the subreddits you are subscribed to.
+func (s *ListingsService) Best(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+	return s.getPosts(ctx, "best", opts)
+}
+
+// Home returns the posts of your front page, i.e. the hottest posts from the subreddits you are subscribed to.
+func (s *ListingsService) Home(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+	return s.getPosts(ctx, "", opts)
+}
+
+// All returns the hottest posts from all subreddits, i.e. r/all.
+func (s *ListingsService) All(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+	return s.getPosts(ctx, "r/all", opts)
+}
+
+// Popular returns the hottest posts from the most popular subreddits, i.e. r/popular.
+func (s *ListingsService) Popular(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+	return s.getPosts(ctx, "r/popular", opts)
+}
+
+func (s *ListingsService) getPosts(ctx context.Context, path string, opts *ListOptions) (*Posts, *Response, error) {
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.getPosts(), resp, nil
+}
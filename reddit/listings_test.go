@@ -15,10 +15,10 @@ var expectedListingPosts = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
+		Thumbnail: "self",
 
 		Title: "This is a title",
 		Body:  "This is some text",
@@ -35,6 +35,8 @@ var expectedListingPosts = []*Post{
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
 
+		WhitelistStatus: "all_ads",
+
 		IsSelfPost: true,
 	},
 }
@@ -44,12 +46,12 @@ var expectedListingComments = []*Comment{
 		ID:      "g05v931",
 		FullID:  "t1_g05v931",
 		Created: &Timestamp{time.Date(2020, 8, 3, 1, 15, 40, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		ParentID:  "t3_i2gvg4",
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/g05v931/",
 
 		Body:     "Test comment",
+		BodyHTML: "&lt;div class=\"md\"&gt;&lt;p&gt;Test comment&lt;/p&gt;\n&lt;/div&gt;",
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
 
@@ -82,6 +84,11 @@ var expectedListingSubreddits = []*Subreddit{
 
 		Subscribers: 8202,
 		Subscribed:  true,
+
+		FreeFormReports:  true,
+		AllowVideos:      true,
+		AllowImages:      true,
+		ShowMediaPreview: true,
 	},
 }
 
@@ -90,10 +97,10 @@ var expectedListingPosts2 = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
+		Thumbnail: "self",
 
 		Title: "This is a title",
 		Body:  "This is some text",
@@ -110,16 +117,18 @@ var expectedListingPosts2 = []*Post{
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
 
+		WhitelistStatus: "all_ads",
+
 		IsSelfPost: true,
 	},
 	{
 		ID:      "i2gvs1",
 		FullID:  "t3_i2gvs1",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 		URL:       "http://example.com",
+		Thumbnail: "default",
 
 		Title: "This is a title",
 
@@ -134,6 +143,8 @@ var expectedListingPosts2 = []*Post{
 
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
+
+		WhitelistStatus: "all_ads",
 	},
 }
 
@@ -180,3 +191,80 @@ func TestListingsService_GetPosts(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedListingPosts2, posts)
 }
+
+func TestListingsService_Best(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/best", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "5")
+		form.Set("after", "t3_after")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.Best(ctx, &ListOptions{Limit: 5, After: "t3_after"})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+}
+
+func TestListingsService_Home(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.Home(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+}
+
+func TestListingsService_All(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/all", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.All(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+}
+
+func TestListingsService_Popular(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/popular", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.Popular(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+}
@@ -0,0 +1,108 @@
+package reddit
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimitWarningThreshold is the number of remaining requests under which
+// the rate limit is logged as a warning.
+const rateLimitWarningThreshold = 10
+
+// redactedHeaders are the headers whose values are never logged.
+// LoggingMiddleware sits underneath the OAuth layer, so it sees the Authorization
+// header holding the access token, or the client's credentials when requesting one.
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+}
+
+// LoggingMiddleware returns a middleware that logs every request sent through it and its response.
+// Requests are logged at the debug level with their method, URL and headers, the values of
+// sensitive headers such as Authorization being redacted.
+// Responses are logged at the debug level with their status, latency and remaining rate limit,
+// or at the warn level if their status is 4xx/5xx or if the rate limit is close to being exhausted.
+// Requests that fail without a response are logged at the warn level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			logRequest(logger, req)
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			logResponse(logger, req, resp, time.Since(start), err)
+
+			return resp, err
+		})
+	}
+}
+
+func logRequest(logger *slog.Logger, req *http.Request) {
+	logger.LogAttrs(req.Context(), slog.LevelDebug, "reddit: request",
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		headerAttr(req.Header),
+	)
+}
+
+func logResponse(logger *slog.Logger, req *http.Request, resp *http.Response, latency time.Duration, err error) {
+	ctx := req.Context()
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Duration("latency", latency),
+	}
+
+	if resp == nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(ctx, slog.LevelWarn, "reddit: request failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+	remaining, hasRemaining := rateLimitRemaining(resp)
+	if hasRemaining {
+		attrs = append(attrs, slog.Float64("remaining", remaining))
+	}
+
+	level := slog.LevelDebug
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	logger.LogAttrs(ctx, level, "reddit: response", attrs...)
+
+	if hasRemaining && remaining < rateLimitWarningThreshold {
+		logger.LogAttrs(ctx, slog.LevelWarn, "reddit: rate limit almost exhausted",
+			slog.Float64("remaining", remaining),
+		)
+	}
+}
+
+func rateLimitRemaining(resp *http.Response) (float64, bool) {
+	v := resp.Header.Get(headerRateLimitRemaining)
+	if v == "" {
+		return 0, false
+	}
+	remaining, err := strconv.ParseFloat(v, 64)
+	return remaining, err == nil
+}
+
+// headerAttr groups the headers in a single attribute, redacting sensitive values.
+func headerAttr(header http.Header) slog.Attr {
+	attrs := make([]any, 0, len(header))
+	for k, v := range header {
+		value := v[0]
+		if len(v) > 1 {
+			value = strings.Join(v, ", ")
+		}
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			value = "REDACTED"
+		}
+		attrs = append(attrs, slog.String(k, value))
+	}
+	return slog.Group("headers", attrs...)
+}
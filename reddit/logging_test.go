@@ -0,0 +1,180 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type capturingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *capturingHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *capturingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *capturingHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *capturingHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+func recordAttrs(r slog.Record) map[string]slog.Value {
+	attrs := make(map[string]slog.Value)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	return attrs
+}
+
+// apiRecords returns the records logged for requests other than the ones to get an access token.
+func (h *capturingHandler) apiRecords() []slog.Record {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var records []slog.Record
+	for _, r := range h.records {
+		if !strings.HasSuffix(recordAttrs(r)["url"].String(), "/api/v1/access_token") {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	handler := new(capturingHandler)
+	client, mux, teardown := setup(WithLogger(slog.New(handler)))
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Remaining", "500.0")
+	})
+	mux.HandleFunc("/api/v1/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Remaining", "3.0")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/ok", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	records := handler.apiRecords()
+	require.Len(t, records, 2)
+
+	request := records[0]
+	require.Equal(t, slog.LevelDebug, request.Level)
+	attrs := recordAttrs(request)
+	require.Equal(t, http.MethodGet, attrs["method"].String())
+	require.Equal(t, fmt.Sprintf("%s/api/v1/ok", client.BaseURL), attrs["url"].String())
+
+	headers := make(map[string]string)
+	for _, a := range attrs["headers"].Group() {
+		headers[a.Key] = a.Value.String()
+	}
+	require.Equal(t, mediaTypeJSON, headers[headerAccept])
+
+	response := records[1]
+	require.Equal(t, slog.LevelDebug, response.Level)
+	attrs = recordAttrs(response)
+	require.Equal(t, int64(http.StatusOK), attrs["status"].Int64())
+	require.Equal(t, 500.0, attrs["remaining"].Float64())
+	require.Equal(t, slog.KindDuration, attrs["latency"].Kind())
+	require.True(t, attrs["latency"].Duration() > time.Duration(0))
+
+	req, err = client.NewRequest(http.MethodGet, "api/v1/missing", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Error(t, err)
+
+	records = handler.apiRecords()
+	require.Len(t, records, 5)
+
+	response = records[3]
+	require.Equal(t, slog.LevelWarn, response.Level)
+	attrs = recordAttrs(response)
+	require.Equal(t, int64(http.StatusNotFound), attrs["status"].Int64())
+
+	rateLimit := records[4]
+	require.Equal(t, slog.LevelWarn, rateLimit.Level)
+	attrs = recordAttrs(rateLimit)
+	require.Equal(t, 3.0, attrs["remaining"].Float64())
+}
+
+func TestLoggingMiddleware_Error(t *testing.T) {
+	handler := new(capturingHandler)
+
+	failing := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://oauth.reddit.com/test", nil)
+	require.NoError(t, err)
+
+	_, err = LoggingMiddleware(slog.New(handler))(failing).RoundTrip(req)
+	require.EqualError(t, err, "connection refused")
+
+	require.Len(t, handler.records, 2)
+
+	failure := handler.records[1]
+	require.Equal(t, slog.LevelWarn, failure.Level)
+	attrs := recordAttrs(failure)
+	require.Equal(t, "https://oauth.reddit.com/test", attrs["url"].String())
+	require.Equal(t, "connection refused", attrs["error"].String())
+}
+
+func TestLoggingMiddleware_RedactsAuthorization(t *testing.T) {
+	handler := new(capturingHandler)
+	client, mux, teardown := setup(WithLogger(slog.New(handler)))
+	defer teardown()
+
+	var authorizations []string
+	mux.HandleFunc("/api/v1/ok", func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/ok", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Bearer token1"}, authorizations)
+
+	var requests int
+	for _, r := range handler.records {
+		if r.Message != "reddit: request" {
+			continue
+		}
+		requests++
+
+		headers := make(map[string]string)
+		for _, a := range recordAttrs(r)["headers"].Group() {
+			headers[a.Key] = a.Value.String()
+		}
+		require.Equal(t, "REDACTED", headers["Authorization"])
+	}
+	// the token request and the request to api/v1/ok
+	require.Equal(t, 2, requests)
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
-
-	"github.com/google/go-querystring/query"
 )
 
 // MessageService handles communication with the message
@@ -33,6 +33,15 @@ type Message struct {
 	To     string `json:"dest"`
 
 	IsComment bool `json:"was_comment"`
+
+	// The following are only set if the message is a comment, e.g. a reply or a username mention.
+	SubredditName string `json:"subreddit"`
+	PostTitle     string `json:"link_title"`
+	// Permalink to the comment, showing the comments leading up to it.
+	Context string `json:"context"`
+
+	// The replies to this message. Only populated by GetThread.
+	Children []*Message `json:"-"`
 }
 
 // Messages is a list of messages.
@@ -42,11 +51,28 @@ type Messages struct {
 	Before   string     `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of messages,
+// or nil if there is no next page.
+func (m *Messages) NextPageOptions() *ListOptions {
+	return nextPageOptions(m.After)
+}
+
 type rootInboxListing struct {
 	Kind string       `json:"kind"`
 	Data inboxListing `json:"data"`
 }
 
+type rootMessageThread struct {
+	Data struct {
+		Children []struct {
+			Data struct {
+				*Message
+				Replies json.RawMessage `json:"replies"`
+			} `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
 type inboxListing struct {
 	Things inboxThings `json:"children"`
 	After  string      `json:"after"`
@@ -248,11 +274,10 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 
 	path := "api/compose"
 
-	form, err := query.Values(sendRequest)
+	form, err := encodeJSONForm(sendRequest)
 	if err != nil {
 		return nil, err
 	}
-	form.Set("api_type", "json")
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -262,6 +287,47 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 	return s.client.Do(ctx, req, nil)
 }
 
+// Reply replies to a message, keeping the reply in the same conversation.
+// parentID is the full ID of the message being replied to, e.g. t4_abc123.
+func (s *MessageService) Reply(ctx context.Context, parentID string, text string) (*Message, *Response, error) {
+	if err := validateFullID(parentID, kindMessage); err != nil {
+		return nil, nil, err
+	}
+	if text == "" {
+		return nil, nil, errors.New("text: cannot be empty")
+	}
+
+	path := "api/comment"
+
+	form := newJSONForm()
+	form.Set("thing_id", parentID)
+	form.Set("text", text)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				Things things `json:"things"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	messages := root.JSON.Data.Things.Messages
+	if len(messages) == 0 {
+		return nil, resp, errors.New("no message was returned")
+	}
+
+	return messages[0], resp, nil
+}
+
 // Inbox returns comments and messages that appear in your inbox, respectively.
 func (s *MessageService) Inbox(ctx context.Context, opts *ListOptions) (*Messages, *Messages, *Response, error) {
 	root, resp, err := s.inbox(ctx, "message/inbox", opts)
@@ -280,6 +346,33 @@ func (s *MessageService) InboxUnread(ctx context.Context, opts *ListOptions) (*M
 	return root.getComments(), root.getMessages(), resp, nil
 }
 
+// Mentions returns comments in which you were mentioned via your username, e.g. u/username.
+func (s *MessageService) Mentions(ctx context.Context, opts *ListOptions) (*Messages, *Response, error) {
+	root, resp, err := s.inbox(ctx, "message/mentions", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getComments(), resp, nil
+}
+
+// CommentReplies returns replies to your comments.
+func (s *MessageService) CommentReplies(ctx context.Context, opts *ListOptions) (*Messages, *Response, error) {
+	root, resp, err := s.inbox(ctx, "message/comments", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getComments(), resp, nil
+}
+
+// PostReplies returns replies to your posts.
+func (s *MessageService) PostReplies(ctx context.Context, opts *ListOptions) (*Messages, *Response, error) {
+	root, resp, err := s.inbox(ctx, "message/selfreply", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getComments(), resp, nil
+}
+
 // Sent returns messages that you've sent.
 func (s *MessageService) Sent(ctx context.Context, opts *ListOptions) (*Messages, *Response, error) {
 	root, resp, err := s.inbox(ctx, "message/sent", opts)
@@ -289,6 +382,83 @@ func (s *MessageService) Sent(ctx context.Context, opts *ListOptions) (*Messages
 	return root.getMessages(), resp, nil
 }
 
+// GetThread returns all the messages of the conversation the message is part of, in chronological order.
+// The message can be specified via its ID or its full ID.
+// The first message is the root of the conversation, and each message's Children holds its direct replies,
+// so the conversation can also be walked as a tree.
+func (s *MessageService) GetThread(ctx context.Context, id string) ([]*Message, *Response, error) {
+	id = strings.TrimPrefix(id, kindMessage+"_")
+	if id == "" {
+		return nil, nil, errors.New("id: cannot be empty")
+	}
+
+	path := fmt.Sprintf("message/messages/%s", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootMessageThread)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	messages := make([]*Message, 0)
+	for _, child := range root.Data.Children {
+		if child.Data.Message == nil {
+			continue
+		}
+		messages = append(messages, child.Data.Message)
+
+		if len(child.Data.Replies) == 0 || child.Data.Replies[0] != '{' {
+			// no replies, reddit returns an empty string
+			continue
+		}
+
+		replies := new(rootInboxListing)
+		err = json.Unmarshal(child.Data.Replies, replies)
+		if err != nil {
+			return nil, resp, err
+		}
+		messages = append(messages, replies.Data.Things.Messages...)
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		if messages[i].Created == nil || messages[j].Created == nil {
+			return false
+		}
+		return messages[i].Created.Before(*messages[j].Created)
+	})
+
+	buildMessageTree(messages)
+
+	return messages, resp, nil
+}
+
+// buildMessageTree sets the children of each message, using the parent IDs to link them.
+// Messages whose parent is not in the list are attached to the first message.
+func buildMessageTree(messages []*Message) {
+	if len(messages) == 0 {
+		return
+	}
+
+	byID := make(map[string]*Message, len(messages))
+	for _, m := range messages {
+		m.Children = nil
+		byID[m.FullID] = m
+	}
+
+	for _, m := range messages[1:] {
+		parent, ok := byID[m.ParentID]
+		if !ok || parent == m {
+			parent = messages[0]
+		}
+		parent.Children = append(parent.Children, m)
+	}
+}
+
 func (s *MessageService) inbox(ctx context.Context, path string, opts *ListOptions) (*rootInboxListing, *Response, error) {
 	path, err := addOptions(path, opts)
 	if err != nil {
@@ -25,6 +25,10 @@ var expectedCommentMessages = &Messages{
 			To:     "testuser2",
 
 			IsComment: true,
+
+			SubredditName: "helloworldtestt",
+			PostTitle:     "post 1",
+			Context:       "/r/helloworldtestt/comments/hs03f3/post_1/g1xi2m9/?context=3",
 		},
 	},
 	After:  "",
@@ -223,6 +227,50 @@ func TestMessageService_Send(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMessageService_Reply(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/message/reply.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("thing_id", "t4_qwki97")
+		form.Set("text", "thanks for the message")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Message.Reply(ctx, "t1_qwki97", "thanks for the message")
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	_, _, err = client.Message.Reply(ctx, "t4_qwki97", "")
+	require.EqualError(t, err, "text: cannot be empty")
+
+	message, _, err := client.Message.Reply(ctx, "t4_qwki97", "thanks for the message")
+	require.NoError(t, err)
+	require.Equal(t, &Message{
+		ID:      "qwki98",
+		FullID:  "t4_qwki98",
+		Created: &Timestamp{time.Date(2020, 7, 21, 21, 0, 40, 0, time.UTC)},
+
+		Subject:  "re: test",
+		Text:     "thanks for the message",
+		ParentID: "t4_qwki97",
+
+		Author: "v_95",
+		To:     "testuser",
+	}, message)
+}
+
 func TestMessageService_Inbox(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -275,3 +323,181 @@ func TestMessageService_Sent(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedMessages, messages)
 }
+
+func TestMessageService_GetThread(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/message/thread.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/messages/msg1", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	msg1 := &Message{
+		ID:      "msg1",
+		FullID:  "t4_msg1",
+		Created: &Timestamp{time.Date(2020, 8, 22, 21, 0, 0, 0, time.UTC)},
+		Subject: "hello",
+		Text:    "hello there",
+		Author:  "user1",
+		To:      "user2",
+	}
+	msg2 := &Message{
+		ID:       "msg2",
+		FullID:   "t4_msg2",
+		Created:  &Timestamp{time.Date(2020, 8, 22, 22, 0, 0, 0, time.UTC)},
+		Subject:  "re: hello",
+		Text:     "hi, how are you?",
+		ParentID: "t4_msg1",
+		Author:   "user2",
+		To:       "user1",
+	}
+	msg3 := &Message{
+		ID:       "msg3",
+		FullID:   "t4_msg3",
+		Created:  &Timestamp{time.Date(2020, 8, 22, 23, 0, 0, 0, time.UTC)},
+		Subject:  "re: hello",
+		Text:     "doing well, thanks",
+		ParentID: "t4_msg2",
+		Author:   "user1",
+		To:       "user2",
+	}
+	msg1.Children = []*Message{msg2}
+	msg2.Children = []*Message{msg3}
+
+	_, _, err = client.Message.GetThread(ctx, "")
+	require.EqualError(t, err, "id: cannot be empty")
+
+	messages, _, err := client.Message.GetThread(ctx, "t4_msg1")
+	require.NoError(t, err)
+	require.Equal(t, []*Message{msg1, msg2, msg3}, messages)
+
+	// the tree starts at the first message
+	require.Len(t, messages[0].Children, 1)
+	require.Equal(t, "msg2", messages[0].Children[0].ID)
+	require.Len(t, messages[0].Children[0].Children, 1)
+	require.Equal(t, "msg3", messages[0].Children[0].Children[0].ID)
+}
+
+func TestMessageService_Mentions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/message/mentions.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/mentions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	mentions, _, err := client.Message.Mentions(ctx, &ListOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, &Messages{
+		Messages: []*Message{
+			{
+				ID:      "g2aaaaa",
+				FullID:  "t1_g2aaaaa",
+				Created: &Timestamp{time.Date(2020, 8, 21, 8, 53, 20, 0, time.UTC)},
+
+				Subject:  "username mention",
+				Text:     "thoughts, u/testuser2?",
+				ParentID: "t3_abc123",
+
+				Author: "testuser1",
+				To:     "testuser2",
+
+				IsComment: true,
+
+				SubredditName: "golang",
+				PostTitle:     "generics are here",
+				Context:       "/r/golang/comments/abc123/generics_are_here/g2aaaaa/?context=3",
+			},
+			{
+				ID:      "g2bbbbb",
+				FullID:  "t1_g2bbbbb",
+				Created: &Timestamp{time.Date(2020, 8, 21, 6, 6, 40, 0, time.UTC)},
+
+				Subject:  "username mention",
+				Text:     "cc u/testuser2",
+				ParentID: "t1_g2zzzzz",
+
+				Author: "testuser3",
+				To:     "testuser2",
+
+				IsComment: true,
+
+				SubredditName: "test",
+				PostTitle:     "post 2",
+				Context:       "/r/test/comments/def456/post_2/g2bbbbb/?context=3",
+			},
+		},
+		After: "t1_g2bbbbb",
+	}, mentions)
+}
+
+func TestMessageService_CommentReplies(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/message/comment-replies.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	replies, _, err := client.Message.CommentReplies(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, &Messages{
+		Messages: []*Message{
+			{
+				ID:      "g1xj3k4",
+				FullID:  "t1_g1xj3k4",
+				Created: &Timestamp{time.Date(2020, 8, 18, 0, 24, 13, 0, time.UTC)},
+
+				Subject:  "comment reply",
+				Text:     "replying to your comment",
+				ParentID: "t1_g1xi2m9",
+
+				Author: "testuser3",
+				To:     "testuser2",
+
+				IsComment: true,
+
+				SubredditName: "helloworldtestt",
+				PostTitle:     "post 1",
+				Context:       "/r/helloworldtestt/comments/hs03f3/post_1/g1xj3k4/?context=3",
+			},
+		},
+	}, replies)
+}
+
+func TestMessageService_PostReplies(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/message/post-replies.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/selfreply", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	replies, _, err := client.Message.PostReplies(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedCommentMessages, replies)
+}
@@ -0,0 +1,40 @@
+package reddit
+
+import (
+	"net/http"
+)
+
+// Middleware wraps a transport to observe or modify the requests sent through it and their responses.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// roundTripperFunc is an adapter to allow the use of ordinary functions as transports.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// chainMiddleware wraps the transport with the middlewares.
+// The first middleware is the outermost one, i.e. the first to see a request.
+func chainMiddleware(rt http.RoundTripper, middlewares []Middleware) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+// HeaderMiddleware returns a middleware that sets the provided headers on every request.
+func HeaderMiddleware(headers map[string]string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req2 := cloneRequest(req)
+			for k, v := range headers {
+				req2.Header.Set(k, v)
+			}
+			return next.RoundTrip(req2)
+		})
+	}
+}
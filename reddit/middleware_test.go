@@ -0,0 +1,77 @@
+package reddit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next.RoundTrip(req)
+				calls = append(calls, name+" response")
+				return resp, err
+			})
+		}
+	}
+
+	modify := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req2 := cloneRequest(req)
+			req2.Header.Set("X-Modified", "request")
+
+			resp, err := next.RoundTrip(req2)
+			if err != nil {
+				return nil, err
+			}
+			resp.Header.Set("X-Modified", "response")
+			return resp, nil
+		})
+	}
+
+	client, mux, teardown := setup(WithMiddleware(record("first"), record("second")), WithMiddleware(modify))
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "request", r.Header.Get("X-Modified"))
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, "response", resp.Header.Get("X-Modified"))
+
+	// the first round trip is for the access token
+	require.Equal(t, []string{
+		"first request", "second request", "second response", "first response",
+		"first request", "second request", "second response", "first response",
+	}, calls)
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	client, mux, teardown := setup(WithMiddleware(HeaderMiddleware(map[string]string{
+		"X-Test":  "value",
+		"X-Other": "other value",
+	})))
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "value", r.Header.Get("X-Test"))
+		require.Equal(t, "other value", r.Header.Get("X-Other"))
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Empty(t, req.Header.Get("X-Test"))
+}
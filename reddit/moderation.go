@@ -2,12 +2,12 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
-
-	"github.com/google/go-querystring/query"
 )
 
 // ModerationService handles communication with the moderation
@@ -25,6 +25,10 @@ type ModAction struct {
 	Action  string     `json:"action,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
 
+	// Extra information about the action, e.g. the reason for a removal or the duration of a ban.
+	Details     string `json:"details,omitempty"`
+	Description string `json:"description,omitempty"`
+
 	Moderator string `json:"mod,omitempty"`
 	// Not the full ID, just the ID36.
 	ModeratorID string `json:"mod_id36,omitempty"`
@@ -50,11 +54,6 @@ func (s *ModerationService) GetActions(ctx context.Context, subreddit string, op
 		return nil, nil, err
 	}
 
-	path, err = addOptions(path, opts)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -69,12 +68,17 @@ func (s *ModerationService) GetActions(ctx context.Context, subreddit string, op
 	return root.getModActions(), resp, nil
 }
 
+// Log gets the moderation log of a subreddit, optionally filtered by moderator and action type.
+// It is the same as GetActions.
+func (s *ModerationService) Log(ctx context.Context, subreddit string, opts *ModLogOptions) (*ModActions, *Response, error) {
+	return s.GetActions(ctx, subreddit, opts)
+}
+
 // AcceptInvite accepts a pending invite to moderate the specified subreddit.
 func (s *ModerationService) AcceptInvite(ctx context.Context, subreddit string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/accept_moderator_invite", subreddit)
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -85,7 +89,12 @@ func (s *ModerationService) AcceptInvite(ctx context.Context, subreddit string)
 }
 
 // Approve approves a post or comment via its full ID.
+// If the ID is not that of a comment, user or post (t1, t2 or t3), ErrInvalidFullID is returned.
 func (s *ModerationService) Approve(ctx context.Context, id string) (*Response, error) {
+	if err := validateFullID(id, kindComment, kindAccount, kindPost); err != nil {
+		return nil, err
+	}
+
 	path := "api/approve"
 
 	form := url.Values{}
@@ -99,13 +108,16 @@ func (s *ModerationService) Approve(ctx context.Context, id string) (*Response,
 	return s.client.Do(ctx, req, nil)
 }
 
-// Remove removes a post, comment or modmail message via its full ID.
-func (s *ModerationService) Remove(ctx context.Context, id string) (*Response, error) {
+func (s *ModerationService) remove(ctx context.Context, id string, spam bool) (*Response, error) {
+	if err := validateFullID(id, kindComment, kindAccount, kindPost); err != nil {
+		return nil, err
+	}
+
 	path := "api/remove"
 
 	form := url.Values{}
 	form.Set("id", id)
-	form.Set("spam", "false")
+	form.Set("spam", fmt.Sprint(spam))
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -115,13 +127,44 @@ func (s *ModerationService) Remove(ctx context.Context, id string) (*Response, e
 	return s.client.Do(ctx, req, nil)
 }
 
-// RemoveSpam removes a post, comment or modmail message via its full ID and marks it as spam.
+// Remove removes a post or comment via its full ID.
+// If the ID is not that of a comment, user or post (t1, t2 or t3), ErrInvalidFullID is returned.
+func (s *ModerationService) Remove(ctx context.Context, id string) (*Response, error) {
+	return s.remove(ctx, id, false)
+}
+
+// RemoveSpam removes a post or comment via its full ID and marks it as spam.
+// This affects the spam score of the author's account.
+// If the ID is not that of a comment, user or post (t1, t2 or t3), ErrInvalidFullID is returned.
 func (s *ModerationService) RemoveSpam(ctx context.Context, id string) (*Response, error) {
-	path := "api/remove"
+	return s.remove(ctx, id, true)
+}
+
+// RemoveWithNote removes a post or comment via its full ID, and attaches a removal note to it.
+// The note is only visible to the moderators of the subreddit.
+// No removal reason is set (i.e. the removal_reason_id is null), only the free-form note.
+// The ID is validated like in Remove.
+func (s *ModerationService) RemoveWithNote(ctx context.Context, id string, note string) (*Response, error) {
+	resp, err := s.remove(ctx, id, false)
+	if err != nil {
+		return resp, err
+	}
+
+	path := "api/v1/modactions/removal_reasons"
+
+	type removalReason struct {
+		IDs      []string `json:"item_ids"`
+		Note     string   `json:"mod_note"`
+		ReasonID *string  `json:"removal_reason_id"`
+	}
+
+	body, err := json.Marshal(&removalReason{IDs: []string{id}, Note: note})
+	if err != nil {
+		return nil, err
+	}
 
 	form := url.Values{}
-	form.Set("id", id)
-	form.Set("spam", "true")
+	form.Set("json", string(body))
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -214,6 +257,50 @@ func (s *ModerationService) UnignoreReports(ctx context.Context, id string) (*Re
 	return s.client.Do(ctx, req, nil)
 }
 
+// Sticky sets whether a post is stickied in its subreddit, via its full ID, and returns its
+// updated sticky state.
+// The slot is the position of the sticky, either 1 (top) or 2 (bottom); it is ignored when unstickying.
+// If the top slot is empty, Reddit stickies the post there regardless of the slot.
+// Subreddit.GetSticky returns the post in each slot.
+func (s *ModerationService) Sticky(ctx context.Context, postID string, slot int, state bool) (bool, *Response, error) {
+	if err := validateFullID(postID, kindPost); err != nil {
+		return false, nil, err
+	}
+
+	if !state {
+		resp, err := s.client.Post.Unsticky(ctx, postID)
+		return false, resp, err
+	}
+
+	if slot != 1 && slot != 2 {
+		return false, nil, fmt.Errorf("slot: must be 1 or 2, got %d", slot)
+	}
+
+	resp, err := s.client.Post.Sticky(ctx, postID, slot == 2)
+	if err != nil {
+		return false, resp, err
+	}
+	return true, resp, nil
+}
+
+// ModPermission is the name of a permission a moderator can have on a subreddit.
+// It is an alias of string, so the constants below can be passed in the []string
+// taken by the methods that grant permissions.
+type ModPermission = string
+
+// Moderator permissions.
+const (
+	ModPermissionAll          ModPermission = "all"
+	ModPermissionAccess       ModPermission = "access"
+	ModPermissionChatConfig   ModPermission = "chat_config"
+	ModPermissionChatOperator ModPermission = "chat_operator"
+	ModPermissionConfig       ModPermission = "config"
+	ModPermissionFlair        ModPermission = "flair"
+	ModPermissionMail         ModPermission = "mail"
+	ModPermissionPosts        ModPermission = "posts"
+	ModPermissionWiki         ModPermission = "wiki"
+)
+
 // ModPermissions are the different permissions moderators have or don't have on a subreddit.
 // Read about them here: https://mods.reddithelp.com/hc/en-us/articles/360009381491-User-Management-moderators-and-permissions
 type ModPermissions struct {
@@ -260,13 +347,41 @@ func (p *ModPermissions) String() (s string) {
 	return
 }
 
+// parseModPermissions builds ModPermissions from a list of permissions.
+// If permissions is nil, nil is returned, which grants all permissions.
+// An error is returned if any of them is not a known moderator permission.
+func parseModPermissions(permissions []string) (*ModPermissions, error) {
+	if permissions == nil {
+		return nil, nil
+	}
+
+	p := new(ModPermissions)
+	t := reflect.TypeOf(*p)
+	v := reflect.ValueOf(p).Elem()
+
+	for _, permission := range permissions {
+		found := false
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Tag.Get("permission") == permission {
+				v.Field(i).SetBool(true)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("permission %q: unknown moderator permission", permission)
+		}
+	}
+
+	return p, nil
+}
+
 // Invite a user to become a moderator of the subreddit.
 // If permissions is nil, all permissions will be granted.
 func (s *ModerationService) Invite(ctx context.Context, subreddit string, username string, permissions *ModPermissions) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("name", username)
 	form.Set("type", "moderator_invite")
 	form.Set("permissions", permissions.String())
@@ -279,21 +394,43 @@ func (s *ModerationService) Invite(ctx context.Context, subreddit string, userna
 	return s.client.Do(ctx, req, nil)
 }
 
+// InviteModerator invites a user to become a moderator of the subreddit with the specified permissions,
+// e.g. "posts" or ModPermissionPosts. Permissions not in the list are not granted. If permissions is nil, all permissions will be granted.
+// An error is returned if any of the permissions is not a known moderator permission.
+func (s *ModerationService) InviteModerator(ctx context.Context, subreddit string, username string, permissions []string) (*Response, error) {
+	p, err := parseModPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	return s.Invite(ctx, subreddit, username, p)
+}
+
+// RemoveModerator removes a user as a moderator of the subreddit.
+func (s *ModerationService) RemoveModerator(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.deleteRelationship(ctx, subreddit, username, "moderator")
+}
+
 // Uninvite a user from becoming a moderator of the subreddit.
 func (s *ModerationService) Uninvite(ctx context.Context, subreddit string, username string) (*Response, error) {
 	return s.deleteRelationship(ctx, subreddit, username, "moderator_invite")
 }
 
-// SetPermissions sets the mod permissions for the user in the subreddit.
+// SetPermissions sets the permissions of an existing moderator of the subreddit,
+// e.g. "posts" or ModPermissionPosts. Permissions not in the list are revoked.
 // If permissions is nil, all permissions will be granted.
-func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string, username string, permissions *ModPermissions) (*Response, error) {
+// An error is returned if any of the permissions is not a known moderator permission.
+func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string, username string, permissions []string) (*Response, error) {
+	p, err := parseModPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("r/%s/api/setpermissions", subreddit)
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("name", username)
-	form.Set("type", "moderator_invite")
-	form.Set("permissions", permissions.String())
+	form.Set("type", "moderator")
+	form.Set("permissions", p.String())
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -305,25 +442,41 @@ func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string
 
 // BanConfig configures the ban of the user being banned.
 type BanConfig struct {
+	// One of the subreddit's rules.
 	Reason string `url:"reason,omitempty"`
 	// Not visible to the user being banned.
 	ModNote string `url:"note,omitempty"`
-	// How long the ban will last. 0-999. Leave nil for permanent.
+	// How long the ban will last, in days. 1-999. Leave nil for permanent.
 	Days *int `url:"duration,omitempty"`
 	// Note to include in the ban message to the user.
 	Message string `url:"ban_message,omitempty"`
+	// Optional. The full ID of the subreddit.
+	ContainerID string `url:"container,omitempty"`
+}
+
+func (c *BanConfig) validate() error {
+	if c == nil || c.Days == nil {
+		return nil
+	}
+	if *c.Days < 1 || *c.Days > 999 {
+		return ErrInvalidDuration
+	}
+	return nil
 }
 
 // Ban a user from the subreddit.
+// If the config's duration is set and is not between 1 and 999 days, ErrInvalidDuration is returned.
 func (s *ModerationService) Ban(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
-	form, err := query.Values(config)
+	form, err := encodeJSONForm(config)
 	if err != nil {
 		return nil, err
 	}
-
-	form.Set("api_type", "json")
 	form.Set("name", username)
 	form.Set("type", "banned")
 
@@ -341,15 +494,18 @@ func (s *ModerationService) Unban(ctx context.Context, subreddit string, usernam
 }
 
 // BanWiki a user from contributing to the subreddit wiki.
+// If the config's duration is set and is not between 1 and 999 days, ErrInvalidDuration is returned.
 func (s *ModerationService) BanWiki(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
-	form, err := query.Values(config)
+	form, err := encodeJSONForm(config)
 	if err != nil {
 		return nil, err
 	}
-
-	form.Set("api_type", "json")
 	form.Set("name", username)
 	form.Set("type", "wikibanned")
 
@@ -367,40 +523,139 @@ func (s *ModerationService) UnbanWiki(ctx context.Context, subreddit string, use
 }
 
 // Mute a user in the subreddit.
+//
+// Deprecated: Use MuteUser instead.
 func (s *ModerationService) Mute(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.createRelationship(ctx, subreddit, username, "muted")
+	return s.MuteUser(ctx, subreddit, username, "")
 }
 
 // Unmute a user in the subreddit.
+//
+// Deprecated: Use UnmuteUser instead.
 func (s *ModerationService) Unmute(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.UnmuteUser(ctx, subreddit, username)
+}
+
+// MuteUser mutes a user in the subreddit, preventing them from sending modmail to it.
+// Reddit automatically unmutes the user after 28 days.
+// The note is optional and is only visible to moderators; use an empty string to omit it.
+// Use Subreddit.Muted to get the list of muted users.
+func (s *ModerationService) MuteUser(ctx context.Context, subreddit string, username string, note string) (*Response, error) {
+	path := fmt.Sprintf("r/%s/api/friend", subreddit)
+
+	form := newJSONForm()
+	form.Set("name", username)
+	form.Set("type", "muted")
+	if note != "" {
+		form.Set("note", note)
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UnmuteUser unmutes a user in the subreddit before their mute expires.
+func (s *ModerationService) UnmuteUser(ctx context.Context, subreddit string, username string) (*Response, error) {
 	return s.deleteRelationship(ctx, subreddit, username, "muted")
 }
 
+// AddContributor adds a user as a contributor (also known as an approved user) to the subreddit.
+// Approved users can submit to restricted and private subreddits.
+// Use Subreddit.Contributors to get the list of contributors.
+func (s *ModerationService) AddContributor(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.createRelationship(ctx, subreddit, username, "contributor")
+}
+
+// RemoveContributor removes a user as a contributor (also known as an approved user) from the subreddit.
+func (s *ModerationService) RemoveContributor(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.deleteRelationship(ctx, subreddit, username, "contributor")
+}
+
+// AddWikiContributor adds a user as an approved wiki contributor to the subreddit.
+// Use Subreddit.WikiContributors to get the list of wiki contributors.
+func (s *ModerationService) AddWikiContributor(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.createRelationship(ctx, subreddit, username, "wikicontributor")
+}
+
+// RemoveWikiContributor removes a user as an approved wiki contributor from the subreddit.
+func (s *ModerationService) RemoveWikiContributor(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.deleteRelationship(ctx, subreddit, username, "wikicontributor")
+}
+
 // ApproveUser adds a user as an approved user to the subreddit.
+//
+// Deprecated: use AddContributor instead.
 func (s *ModerationService) ApproveUser(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.createRelationship(ctx, subreddit, username, "contributor")
+	return s.AddContributor(ctx, subreddit, username)
 }
 
 // UnapproveUser removes a user as an approved user to the subreddit.
+//
+// Deprecated: use RemoveContributor instead.
 func (s *ModerationService) UnapproveUser(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.deleteRelationship(ctx, subreddit, username, "contributor")
+	return s.RemoveContributor(ctx, subreddit, username)
 }
 
 // ApproveUserWiki adds a user as an approved wiki contributor in the subreddit.
+//
+// Deprecated: use AddWikiContributor instead.
 func (s *ModerationService) ApproveUserWiki(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.createRelationship(ctx, subreddit, username, "wikicontributor")
+	return s.AddWikiContributor(ctx, subreddit, username)
 }
 
 // UnapproveUserWiki removes a user as an approved wiki contributor in the subreddit.
+//
+// Deprecated: use RemoveWikiContributor instead.
 func (s *ModerationService) UnapproveUserWiki(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.deleteRelationship(ctx, subreddit, username, "wikicontributor")
+	return s.RemoveWikiContributor(ctx, subreddit, username)
+}
+
+// maxStylesheetLength is the maximum size of a subreddit's stylesheet, in bytes.
+const maxStylesheetLength = 100000
+
+// UpdateStylesheet replaces the subreddit's stylesheet with the provided CSS.
+// The reason is optional and is shown in the stylesheet's revision history.
+// If the CSS is longer than 100,000 bytes, ErrStylesheetTooLong is returned.
+func (s *ModerationService) UpdateStylesheet(ctx context.Context, subreddit string, css string, reason string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if len(css) > maxStylesheetLength {
+		return nil, ErrStylesheetTooLong
+	}
+
+	path := fmt.Sprintf("r/%s/api/subreddit_stylesheet", subreddit)
+
+	form := newJSONForm()
+	form.Set("op", "save")
+	form.Set("stylesheet_contents", css)
+	if reason != "" {
+		form.Set("reason", reason)
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
 }
 
 func (s *ModerationService) createRelationship(ctx context.Context, subreddit, username, relationship string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if username == "" {
+		return nil, errors.New("username: cannot be empty")
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("name", username)
 	form.Set("type", relationship)
 
@@ -409,14 +664,21 @@ func (s *ModerationService) createRelationship(ctx context.Context, subreddit, u
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	return resp, relationshipError(err)
 }
 
 func (s *ModerationService) deleteRelationship(ctx context.Context, subreddit, username, relationship string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if username == "" {
+		return nil, errors.New("username: cannot be empty")
+	}
+
 	path := fmt.Sprintf("r/%s/api/unfriend", subreddit)
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("name", username)
 	form.Set("type", relationship)
 
@@ -425,5 +687,24 @@ func (s *ModerationService) deleteRelationship(ctx context.Context, subreddit, u
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	return resp, relationshipError(err)
+}
+
+// relationshipError maps the errors Reddit returns for friend/unfriend requests
+// to ErrSubredditNotFound and ErrUserNotFound when applicable.
+func relationshipError(err error) error {
+	switch e := err.(type) {
+	case *ErrorResponse:
+		if e.Response.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: %w", ErrSubredditNotFound, err)
+		}
+	case *JSONErrorResponse:
+		for _, apiErr := range e.JSON.Errors {
+			if apiErr.Label == "USER_DOESNT_EXIST" {
+				return fmt.Errorf("%w: %w", ErrUserNotFound, err)
+			}
+		}
+	}
+	return err
 }
@@ -1,9 +1,11 @@
 package reddit
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +27,8 @@ var expectedModActions = &ModActions{
 			TargetPermalink: "/r/helloworldtestt/comments/hq6r3t/yo/fxw10aa/",
 			TargetBody:      "hi",
 
+			Details: "spam",
+
 			Subreddit:   "helloworldtestt",
 			SubredditID: "2uquw1",
 		},
@@ -75,6 +79,37 @@ func TestModerationService_GetActions(t *testing.T) {
 	require.Equal(t, expectedModActions, modActions)
 }
 
+func TestModerationService_Log(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/moderation/actions.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/log", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("type", "removelink")
+		form.Set("mod", "testmod")
+		form.Set("limit", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	modActions, _, err := client.Moderation.Log(ctx, "testsubreddit", &ModLogOptions{
+		ListOptions: ListOptions{Limit: 10},
+		Type:        "removelink",
+		Moderator:   "testmod",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModActions, modActions)
+}
+
 func TestModerationService_AcceptInvite(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -103,18 +138,29 @@ func TestModerationService_Approve(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
 
+	id := "t3_test"
 	mux.HandleFunc("/api/approve", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
 
 		form := url.Values{}
-		form.Set("id", "t3_test")
+		form.Set("id", id)
 
 		err := r.ParseForm()
 		require.NoError(t, err)
 		require.Equal(t, form, r.Form)
 	})
 
-	_, err := client.Moderation.Approve(ctx, "t3_test")
+	_, err := client.Moderation.Approve(ctx, "t5_test")
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+
+	_, err = client.Moderation.Approve(ctx, "t4_test")
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+
+	_, err = client.Moderation.Approve(ctx, "t3_test")
+	require.NoError(t, err)
+
+	id = "t1_test"
+	_, err = client.Moderation.Approve(ctx, "t1_test")
 	require.NoError(t, err)
 }
 
@@ -122,11 +168,12 @@ func TestModerationService_Remove(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
 
+	id := "t3_test"
 	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
 
 		form := url.Values{}
-		form.Set("id", "t3_test")
+		form.Set("id", id)
 		form.Set("spam", "false")
 
 		err := r.ParseForm()
@@ -134,7 +181,18 @@ func TestModerationService_Remove(t *testing.T) {
 		require.Equal(t, form, r.Form)
 	})
 
-	_, err := client.Moderation.Remove(ctx, "t3_test")
+	_, err := client.Moderation.Remove(ctx, "test")
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+
+	// only comments, users and posts can be removed
+	_, err = client.Moderation.Remove(ctx, "t4_test")
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+
+	_, err = client.Moderation.Remove(ctx, "t3_test")
+	require.NoError(t, err)
+
+	id = "t1_test"
+	_, err = client.Moderation.Remove(ctx, "t1_test")
 	require.NoError(t, err)
 }
 
@@ -158,6 +216,37 @@ func TestModerationService_RemoveSpam(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_RemoveWithNote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t1_test")
+		form.Set("spam", "false")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+	})
+
+	mux.HandleFunc("/api/v1/modactions/removal_reasons", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("json", `{"item_ids":["t1_test"],"mod_note":"rule 1","removal_reason_id":null}`)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+	})
+
+	_, err := client.Moderation.RemoveWithNote(ctx, "t1_test", "rule 1")
+	require.NoError(t, err)
+}
+
 func TestModerationService_Leave(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -261,6 +350,52 @@ func TestModerationService_UnignoreReports(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_Sticky(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var expected url.Values
+	mux.HandleFunc("/api/set_subreddit_sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, expected, r.PostForm)
+	})
+
+	_, _, err := client.Moderation.Sticky(ctx, "t1_test", 1, true)
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	_, _, err = client.Moderation.Sticky(ctx, "t3_test", 0, true)
+	require.EqualError(t, err, "slot: must be 1 or 2, got 0")
+
+	_, _, err = client.Moderation.Sticky(ctx, "t3_test", 3, true)
+	require.EqualError(t, err, "slot: must be 1 or 2, got 3")
+
+	expected = url.Values{}
+	expected.Set("api_type", "json")
+	expected.Set("id", "t3_test")
+	expected.Set("num", "1")
+	expected.Set("state", "true")
+
+	stickied, _, err := client.Moderation.Sticky(ctx, "t3_test", 1, true)
+	require.NoError(t, err)
+	require.True(t, stickied)
+
+	expected.Del("num")
+
+	stickied, _, err = client.Moderation.Sticky(ctx, "t3_test", 2, true)
+	require.NoError(t, err)
+	require.True(t, stickied)
+
+	expected.Set("state", "false")
+
+	// the slot is not validated when unstickying
+	stickied, _, err = client.Moderation.Sticky(ctx, "t3_test", 0, false)
+	require.NoError(t, err)
+	require.False(t, stickied)
+}
+
 func TestModerationService_Invite(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -305,6 +440,52 @@ func TestModerationService_Invite_Permissions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_InviteModerator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "moderator_invite")
+		form.Set("permissions", "-all,-access,-chat_config,-chat_operator,-config,-flair,+mail,+posts,-wiki")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.InviteModerator(ctx, "testsubreddit", "testuser", []string{ModPermissionPosts, "invalid"})
+	require.EqualError(t, err, `permission "invalid": unknown moderator permission`)
+
+	_, err = client.Moderation.InviteModerator(ctx, "testsubreddit", "testuser", []string{ModPermissionPosts, ModPermissionMail})
+	require.NoError(t, err)
+}
+
+func TestModerationService_RemoveModerator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "moderator")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.RemoveModerator(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
 func TestModerationService_Uninvite(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -330,21 +511,30 @@ func TestModerationService_SetPermissions(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
 
+	var permissions string
 	mux.HandleFunc("/r/testsubreddit/api/setpermissions", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
 
 		form := url.Values{}
 		form.Set("api_type", "json")
 		form.Set("name", "testuser")
-		form.Set("type", "moderator_invite")
-		form.Set("permissions", "-all,+access,-chat_config,-chat_operator,-config,+flair,-mail,+posts,-wiki")
+		form.Set("type", "moderator")
+		form.Set("permissions", permissions)
 
 		err := r.ParseForm()
 		require.NoError(t, err)
 		require.Equal(t, form, r.PostForm)
 	})
 
-	_, err := client.Moderation.SetPermissions(ctx, "testsubreddit", "testuser", &ModPermissions{Access: true, Flair: true, Posts: true})
+	_, err := client.Moderation.SetPermissions(ctx, "testsubreddit", "testuser", []string{"posts", "invalid"})
+	require.EqualError(t, err, `permission "invalid": unknown moderator permission`)
+
+	permissions = "+all"
+	_, err = client.Moderation.SetPermissions(ctx, "testsubreddit", "testuser", nil)
+	require.NoError(t, err)
+
+	permissions = "-all,+access,-chat_config,-chat_operator,-config,+flair,-mail,+posts,-wiki"
+	_, err = client.Moderation.SetPermissions(ctx, "testsubreddit", "testuser", []string{ModPermissionAccess, "flair", ModPermissionPosts})
 	require.NoError(t, err)
 }
 
@@ -378,6 +568,46 @@ func TestModerationService_Ban(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_Ban_Permanent(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "banned")
+		form.Set("reason", "test reason")
+		form.Set("container", "t5_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{
+		Reason:      "test reason",
+		ContainerID: "t5_test",
+	})
+	require.NoError(t, err)
+}
+
+func TestModerationService_Ban_InvalidDuration(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	_, err := client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(0)})
+	require.Equal(t, ErrInvalidDuration, err)
+
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(1000)})
+	require.Equal(t, ErrInvalidDuration, err)
+
+	_, err = client.Moderation.BanWiki(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(-1)})
+	require.Equal(t, ErrInvalidDuration, err)
+}
+
 func TestModerationService_Unban(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -492,6 +722,185 @@ func TestModerationService_Unmuted(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_MuteUser(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "muted")
+		form.Set("note", "spamming modmail")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.MuteUser(ctx, "testsubreddit", "testuser", "spamming modmail")
+	require.NoError(t, err)
+}
+
+func TestModerationService_UnmuteUser(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "muted")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.UnmuteUser(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
+func TestModerationService_AddContributor(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "contributor")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.AddContributor(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
+func TestModerationService_RemoveContributor(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "contributor")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.RemoveContributor(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
+func TestModerationService_AddContributor_Errors(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, `{"json": {"errors": [["USER_DOESNT_EXIST", "that user doesn't exist", "name"]]}}`)
+	})
+
+	mux.HandleFunc("/r/doesnotexist/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Moderation.AddContributor(ctx, "", "testuser")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Moderation.AddContributor(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "username: cannot be empty")
+
+	_, err = client.Moderation.AddContributor(ctx, "testsubreddit", "doesnotexist")
+	require.True(t, errors.Is(err, ErrUserNotFound))
+	var jsonErr *JSONErrorResponse
+	require.True(t, errors.As(err, &jsonErr))
+
+	_, err = client.Moderation.AddContributor(ctx, "doesnotexist", "testuser")
+	require.True(t, errors.Is(err, ErrSubredditNotFound))
+	require.True(t, errors.Is(err, ErrNotFound))
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusNotFound, errResp.Response.StatusCode)
+}
+
+func TestModerationService_RemoveContributor_Errors(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/doesnotexist/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Moderation.RemoveContributor(ctx, "", "testuser")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Moderation.RemoveContributor(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "username: cannot be empty")
+
+	_, err = client.Moderation.RemoveContributor(ctx, "doesnotexist", "testuser")
+	require.True(t, errors.Is(err, ErrSubredditNotFound))
+}
+
+func TestModerationService_AddWikiContributor(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "wikicontributor")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.AddWikiContributor(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
+func TestModerationService_RemoveWikiContributor(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "wikicontributor")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.RemoveWikiContributor(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
 func TestModerationService_ApproveUser(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -575,3 +984,31 @@ func TestModerationService_UnapproveUserWiki(t *testing.T) {
 	_, err := client.Moderation.UnapproveUserWiki(ctx, "testsubreddit", "testuser")
 	require.NoError(t, err)
 }
+
+func TestModerationService_UpdateStylesheet(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/subreddit_stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("op", "save")
+		form.Set("stylesheet_contents", ".side { display: none; }")
+		form.Set("reason", "hide the sidebar")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.UpdateStylesheet(ctx, "", "", "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Moderation.UpdateStylesheet(ctx, "testsubreddit", strings.Repeat("a", 100001), "")
+	require.ErrorIs(t, err, ErrStylesheetTooLong)
+
+	_, err = client.Moderation.UpdateStylesheet(ctx, "testsubreddit", ".side { display: none; }", "hide the sidebar")
+	require.NoError(t, err)
+}
@@ -0,0 +1,318 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ModmailService handles communication with the modmail
+// related methods of the Reddit API.
+// This is the new modmail, which is separate from the messages found in your inbox.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_modmail
+type ModmailService struct {
+	client *Client
+}
+
+// ModmailConversation is a conversation in modmail.
+type ModmailConversation struct {
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+	// The name of the subreddit the conversation belongs to.
+	Subreddit string `json:"-"`
+
+	Authors     []*ModmailAuthor `json:"authors"`
+	NumMessages int              `json:"numMessages"`
+	// Only populated with the messages that were included in the response.
+	Messages []*ModmailMessage `json:"-"`
+
+	// 0: new, 1: in progress, 2: archived, 3: appeals, 4: join requests, 5: filtered.
+	State int `json:"state"`
+
+	IsAuto        bool `json:"isAuto"`
+	IsInternal    bool `json:"isInternal"`
+	IsHighlighted bool `json:"isHighlighted"`
+	IsRepliable   bool `json:"isRepliable"`
+
+	LastUpdated    *Timestamp `json:"lastUpdated,omitempty"`
+	LastUserUpdate *Timestamp `json:"lastUserUpdate,omitempty"`
+	LastModUpdate  *Timestamp `json:"lastModUpdate,omitempty"`
+	LastUnread     *Timestamp `json:"lastUnread,omitempty"`
+
+	messageIDs []string
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *ModmailConversation) UnmarshalJSON(b []byte) error {
+	type alias ModmailConversation
+	root := &struct {
+		*alias
+		Owner struct {
+			DisplayName string `json:"displayName"`
+		} `json:"owner"`
+		ObjIDs []struct {
+			ID  string `json:"id"`
+			Key string `json:"key"`
+		} `json:"objIds"`
+	}{alias: (*alias)(c)}
+
+	err := json.Unmarshal(b, root)
+	if err != nil {
+		return err
+	}
+
+	c.Subreddit = root.Owner.DisplayName
+	c.messageIDs = nil
+	for _, obj := range root.ObjIDs {
+		if obj.Key == "messages" {
+			c.messageIDs = append(c.messageIDs, obj.ID)
+		}
+	}
+
+	return nil
+}
+
+// attachMessages sets the conversation's messages, in order, from the provided ones.
+func (c *ModmailConversation) attachMessages(messages map[string]*ModmailMessage) {
+	c.Messages = make([]*ModmailMessage, 0, len(c.messageIDs))
+	for _, id := range c.messageIDs {
+		if message, ok := messages[id]; ok {
+			c.Messages = append(c.Messages, message)
+		}
+	}
+}
+
+// ModmailConversations is a list of modmail conversations.
+type ModmailConversations struct {
+	Conversations []*ModmailConversation `json:"conversations"`
+	// The ID of the last conversation, to be used to get the next page.
+	After string `json:"after"`
+}
+
+// ModmailMessage is a message in a modmail conversation.
+type ModmailMessage struct {
+	ID     string         `json:"id"`
+	Author *ModmailAuthor `json:"author"`
+
+	Body     string `json:"bodyMarkdown"`
+	BodyHTML string `json:"body"`
+
+	// Internal messages are only visible to the moderators of the subreddit.
+	IsInternal bool       `json:"isInternal"`
+	Created    *Timestamp `json:"date,omitempty"`
+}
+
+// ModmailAuthor is a participant of a modmail conversation.
+type ModmailAuthor struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	IsMod         bool `json:"isMod"`
+	IsAdmin       bool `json:"isAdmin"`
+	IsOP          bool `json:"isOp"`
+	IsParticipant bool `json:"isParticipant"`
+	IsHidden      bool `json:"isHidden"`
+	IsDeleted     bool `json:"isDeleted"`
+}
+
+// ModmailListOptions defines possible options used when getting modmail conversations.
+type ModmailListOptions struct {
+	// Maximum number of conversations to be returned.
+	// The default is 25 and max is 100.
+	Limit int `url:"limit,omitempty"`
+	// The ID of a conversation. Only conversations appearing after it will be returned.
+	After string `url:"after,omitempty"`
+	// The names of the subreddits to get conversations from.
+	// Defaults to all the subreddits you moderate.
+	Subreddits []string `url:"entity,comma,omitempty"`
+	// One of: recent, mod, user, unread.
+	Sort string `url:"sort,omitempty"`
+	// One of: all, appeals, notifications, inbox, filtered, inprogress, mod, archived,
+	// default, highlighted, join_requests, new.
+	State string `url:"state,omitempty"`
+}
+
+// CreateModmailRequest represents a request to start a modmail conversation.
+type CreateModmailRequest struct {
+	// The name of the subreddit the conversation is sent from.
+	Subreddit string `url:"srName"`
+	// The username of the user the conversation is sent to.
+	To      string `url:"to"`
+	Subject string `url:"subject"`
+	Body    string `url:"body"`
+	// If true, the message will look like it came from the subreddit instead of you.
+	HideAuthor bool `url:"isAuthorHidden"`
+}
+
+type rootModmailConversations struct {
+	Conversations   map[string]*ModmailConversation `json:"conversations"`
+	ConversationIDs []string                        `json:"conversationIds"`
+	Messages        map[string]*ModmailMessage      `json:"messages"`
+}
+
+type rootModmailConversation struct {
+	Conversation *ModmailConversation       `json:"conversation"`
+	Messages     map[string]*ModmailMessage `json:"messages"`
+}
+
+// GetConversations returns modmail conversations of the subreddits you moderate.
+func (s *ModmailService) GetConversations(ctx context.Context, opts *ModmailListOptions) (*ModmailConversations, *Response, error) {
+	path, err := addOptions("api/mod/conversations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootModmailConversations)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conversations := &ModmailConversations{Conversations: make([]*ModmailConversation, 0, len(root.ConversationIDs))}
+	for _, id := range root.ConversationIDs {
+		conversation, ok := root.Conversations[id]
+		if !ok {
+			continue
+		}
+		conversation.attachMessages(root.Messages)
+		conversations.Conversations = append(conversations.Conversations, conversation)
+		conversations.After = id
+	}
+
+	return conversations, resp, nil
+}
+
+// GetConversation returns a modmail conversation and its messages.
+func (s *ModmailService) GetConversation(ctx context.Context, id string) (*ModmailConversation, *Response, error) {
+	path := fmt.Sprintf("api/mod/conversations/%s", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doConversation(ctx, req)
+}
+
+// CreateConversation starts a modmail conversation between a subreddit and a user.
+func (s *ModmailService) CreateConversation(ctx context.Context, createRequest *CreateModmailRequest) (*ModmailConversation, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("createRequest: cannot be nil")
+	}
+
+	path := "api/mod/conversations"
+
+	form, err := encodeForm(createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doConversation(ctx, req)
+}
+
+// ReplyToConversation replies to a modmail conversation.
+// If internal is true, the reply is a private moderator note only visible to the subreddit's moderators.
+func (s *ModmailService) ReplyToConversation(ctx context.Context, id string, body string, internal bool) (*Response, error) {
+	path := fmt.Sprintf("api/mod/conversations/%s", id)
+
+	form := url.Values{}
+	form.Set("body", body)
+	form.Set("isInternal", fmt.Sprint(internal))
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ArchiveConversation archives a modmail conversation.
+func (s *ModmailService) ArchiveConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodPost, id, "archive")
+}
+
+// UnarchiveConversation unarchives a modmail conversation.
+func (s *ModmailService) UnarchiveConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodPost, id, "unarchive")
+}
+
+// HighlightConversation highlights a modmail conversation.
+func (s *ModmailService) HighlightConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodPost, id, "highlight")
+}
+
+// UnhighlightConversation removes the highlight from a modmail conversation.
+func (s *ModmailService) UnhighlightConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodDelete, id, "highlight")
+}
+
+// MuteConversation mutes the non-moderator user of a modmail conversation for 72 hours.
+// A muted user cannot send modmail to the subreddit.
+func (s *ModmailService) MuteConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodPost, id, "mute")
+}
+
+// UnmuteConversation unmutes the non-moderator user of a modmail conversation.
+func (s *ModmailService) UnmuteConversation(ctx context.Context, id string) (*Response, error) {
+	return s.conversationAction(ctx, http.MethodPost, id, "unmute")
+}
+
+// GetUnreadCount returns the number of unread modmail conversations, keyed by state,
+// e.g. new, inprogress, highlighted, etc.
+func (s *ModmailService) GetUnreadCount(ctx context.Context) (map[string]int, *Response, error) {
+	path := "api/mod/conversations/unread/count"
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := make(map[string]int)
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+func (s *ModmailService) doConversation(ctx context.Context, req *http.Request) (*ModmailConversation, *Response, error) {
+	root := new(rootModmailConversation)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if root.Conversation == nil {
+		return nil, resp, nil
+	}
+	root.Conversation.attachMessages(root.Messages)
+
+	return root.Conversation, resp, nil
+}
+
+func (s *ModmailService) conversationAction(ctx context.Context, method string, id string, action string) (*Response, error) {
+	path := fmt.Sprintf("api/mod/conversations/%s/%s", id, action)
+
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,267 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var expectedModmailUser = &ModmailAuthor{
+	ID:            123456,
+	Name:          "testuser",
+	IsOP:          true,
+	IsParticipant: true,
+}
+
+var expectedModmailMod = &ModmailAuthor{
+	ID:       654321,
+	Name:     "testmod",
+	IsMod:    true,
+	IsHidden: true,
+}
+
+var expectedModmailConversation = &ModmailConversation{
+	ID:        "abc12",
+	Subject:   "question about the rules",
+	Subreddit: "testsubreddit",
+
+	Authors:     []*ModmailAuthor{expectedModmailUser, expectedModmailMod},
+	NumMessages: 2,
+	Messages: []*ModmailMessage{
+		{
+			ID:       "msg11",
+			Author:   expectedModmailUser,
+			Body:     "hello",
+			BodyHTML: `<!-- SC_OFF --><div class="md"><p>hello</p></div><!-- SC_ON -->`,
+			Created:  &Timestamp{time.Date(2020, 9, 5, 14, 29, 44, 546209000, time.UTC)},
+		},
+		{
+			ID:       "msg12",
+			Author:   expectedModmailMod,
+			Body:     "hi there",
+			BodyHTML: `<!-- SC_OFF --><div class="md"><p>hi there</p></div><!-- SC_ON -->`,
+			Created:  &Timestamp{time.Date(2020, 9, 5, 15, 1, 2, 123456000, time.UTC)},
+		},
+	},
+
+	State: 1,
+
+	IsHighlighted: true,
+	IsRepliable:   true,
+
+	LastUpdated:    &Timestamp{time.Date(2020, 9, 5, 15, 1, 2, 123456000, time.UTC)},
+	LastUserUpdate: &Timestamp{time.Date(2020, 9, 5, 14, 29, 44, 546209000, time.UTC)},
+	LastModUpdate:  &Timestamp{time.Date(2020, 9, 5, 15, 1, 2, 123456000, time.UTC)},
+
+	messageIDs: []string{"msg11", "msg12"},
+}
+
+func TestModmailService_GetConversations(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/modmail/conversations.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "10")
+		form.Set("entity", "testsubreddit,testsubreddit2")
+		form.Set("sort", "recent")
+		form.Set("state", "inprogress")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	conversations, _, err := client.Modmail.GetConversations(ctx, &ModmailListOptions{
+		Limit:      10,
+		Subreddits: []string{"testsubreddit", "testsubreddit2"},
+		Sort:       "recent",
+		State:      "inprogress",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &ModmailConversations{
+		Conversations: []*ModmailConversation{expectedModmailConversation},
+		After:         "abc12",
+	}, conversations)
+}
+
+func TestModmailService_GetConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/modmail/conversation.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations/abc12", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	conversation, _, err := client.Modmail.GetConversation(ctx, "abc12")
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversation, conversation)
+}
+
+func TestModmailService_CreateConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/modmail/conversation.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("srName", "testsubreddit")
+		form.Set("to", "testuser")
+		form.Set("subject", "question about the rules")
+		form.Set("body", "hello")
+		form.Set("isAuthorHidden", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Modmail.CreateConversation(ctx, nil)
+	require.EqualError(t, err, "createRequest: cannot be nil")
+
+	conversation, _, err := client.Modmail.CreateConversation(ctx, &CreateModmailRequest{
+		Subreddit:  "testsubreddit",
+		To:         "testuser",
+		Subject:    "question about the rules",
+		Body:       "hello",
+		HideAuthor: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversation, conversation)
+}
+
+func TestModmailService_ReplyToConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("body", "a note for the other mods")
+		form.Set("isInternal", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Modmail.ReplyToConversation(ctx, "abc12", "a note for the other mods", true)
+	require.NoError(t, err)
+}
+
+func TestModmailService_ArchiveConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/archive", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.ArchiveConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_UnarchiveConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/unarchive", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.UnarchiveConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_HighlightConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/highlight", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.HighlightConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_UnhighlightConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/highlight", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := client.Modmail.UnhighlightConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_MuteConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/mute", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.MuteConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_UnmuteConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/abc12/unmute", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.UnmuteConversation(ctx, "abc12")
+	require.NoError(t, err)
+}
+
+func TestModmailService_GetUnreadCount(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/mod/conversations/unread/count", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"archived": 0, "appeals": 0, "highlighted": 1, "notifications": 0, "join_requests": 0, "new": 3, "inprogress": 2, "mod": 0}`)
+	})
+
+	count, _, err := client.Modmail.GetUnreadCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{
+		"archived":      0,
+		"appeals":       0,
+		"highlighted":   1,
+		"notifications": 0,
+		"join_requests": 0,
+		"new":           3,
+		"inprogress":    2,
+		"mod":           0,
+	}, count)
+}
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-
-	"github.com/google/go-querystring/query"
 )
 
 // MultiService handles communication with the multireddit
@@ -191,7 +189,7 @@ func (s *MultiService) Copy(ctx context.Context, copyRequest *MultiCopyRequest)
 	}
 
 	path := "api/multi/copy"
-	form, err := query.Values(copyRequest)
+	form, err := encodeForm(copyRequest)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,132 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ResolvePermalink returns the kind and ID36 of the post or comment a link points to,
+// e.g. t3 and abc123 for https://www.reddit.com/r/golang/comments/abc123/title/.
+// Post and comment permalinks, as well as redd.it short links, are parsed without making a request.
+// Share links, e.g. https://www.reddit.com/r/golang/s/AbCdEf, are resolved by following
+// their redirect with a HEAD request.
+// If the link does not point to a post or comment, the error matches ErrInvalidPermalink.
+func (c *Client) ResolvePermalink(ctx context.Context, rawurl string) (kind, id string, err error) {
+	u, err := parsePermalinkURL(rawurl)
+	if err != nil {
+		return "", "", err
+	}
+	if !c.isRedditHost(u.Host) {
+		return "", "", fmt.Errorf("%w: %q is not a reddit link", ErrInvalidPermalink, rawurl)
+	}
+
+	if isShareLink(u) {
+		u, err = c.followShareLink(ctx, u)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	kind, id, ok := parsePermalink(u)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidPermalink, rawurl)
+	}
+	return kind, id, nil
+}
+
+// parsePermalinkURL parses the link, adding the https scheme if it is missing, e.g. redd.it/abc123.
+func parsePermalinkURL(rawurl string) (*url.URL, error) {
+	rawurl = strings.TrimSpace(rawurl)
+	if !strings.Contains(rawurl, "://") {
+		rawurl = "https://" + rawurl
+	}
+
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPermalink, err)
+	}
+	return u, nil
+}
+
+func (c *Client) isRedditHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "reddit.com" || strings.HasSuffix(host, ".reddit.com") ||
+		host == "redd.it" || host == c.BaseURL.Host
+}
+
+// isShareLink reports whether the link is a share link, i.e. /r/{subreddit}/s/{code},
+// which Reddit redirects to the permalink of the post or comment.
+func isShareLink(u *url.URL) bool {
+	segments := pathSegments(u)
+	return len(segments) == 4 && segments[0] == "r" && segments[2] == "s"
+}
+
+// followShareLink returns the URL the share link redirects to.
+// The request goes through Do without following the redirect, so its status is 3xx
+// and Do reports it as an error, which is ignored if the response has a location.
+func (c *Client) followShareLink(ctx context.Context, u *url.URL) (*url.URL, error) {
+	req, err := c.NewRequest(http.MethodHead, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(withoutRedirects(ctx), req, nil)
+	if resp == nil {
+		return nil, err
+	}
+
+	location, locErr := resp.Location()
+	if locErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: share link %q did not redirect", ErrInvalidPermalink, u)
+	}
+	return location, nil
+}
+
+// parsePermalink extracts the kind and ID36 of the post or comment from its link.
+// Supported formats:
+//   - redd.it/{post}
+//   - /r/{subreddit}/comments/{post}[/{title}[/{comment}]]
+//   - /comments/{post}[/{title}[/{comment}]]
+//   - /gallery/{post}
+func parsePermalink(u *url.URL) (kind, id string, ok bool) {
+	segments := pathSegments(u)
+
+	if strings.EqualFold(u.Host, "redd.it") {
+		if len(segments) != 1 {
+			return "", "", false
+		}
+		return kindPost, segments[0], true
+	}
+
+	if len(segments) >= 2 && segments[0] == "r" {
+		segments = segments[2:]
+	}
+	if len(segments) == 2 && segments[0] == "gallery" {
+		return kindPost, segments[1], true
+	}
+	if len(segments) < 2 || segments[0] != "comments" {
+		return "", "", false
+	}
+
+	if len(segments) >= 4 {
+		return kindComment, segments[3], true
+	}
+	return kindPost, segments[1], true
+}
+
+// pathSegments returns the non-empty segments of the URL's path.
+func pathSegments(u *url.URL) []string {
+	var segments []string
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
@@ -0,0 +1,80 @@
+package reddit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ResolvePermalink(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	tests := []struct {
+		url  string
+		kind string
+		id   string
+	}{
+		{"https://www.reddit.com/r/golang/comments/abc123/go_121_is_released/", kindPost, "abc123"},
+		{"https://old.reddit.com/r/golang/comments/abc123/", kindPost, "abc123"},
+		{"reddit.com/comments/abc123", kindPost, "abc123"},
+		{"https://www.reddit.com/r/golang/comments/abc123/go_121_is_released/def456/?context=3", kindComment, "def456"},
+		{"https://www.reddit.com/comments/abc123/_/def456", kindComment, "def456"},
+		{"https://www.reddit.com/gallery/abc123", kindPost, "abc123"},
+		{"https://redd.it/abc123", kindPost, "abc123"},
+		{"redd.it/abc123", kindPost, "abc123"},
+	}
+	for _, tc := range tests {
+		kind, id, err := client.ResolvePermalink(ctx, tc.url)
+		require.NoError(t, err, tc.url)
+		require.Equal(t, tc.kind, kind, tc.url)
+		require.Equal(t, tc.id, id, tc.url)
+	}
+
+	for _, rawurl := range []string{
+		"https://example.com/r/golang/comments/abc123/",
+		"https://www.reddit.com/r/golang/",
+		"https://www.reddit.com/user/testuser",
+		"https://redd.it/",
+		"%",
+	} {
+		_, _, err := client.ResolvePermalink(ctx, rawurl)
+		require.ErrorIs(t, err, ErrInvalidPermalink, rawurl)
+	}
+}
+
+func TestClient_ResolvePermalink_ShareLink(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/golang/s/AbCdEf", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		http.Redirect(w, r, "https://www.reddit.com/r/golang/comments/abc123/go_121_is_released/def456/", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/r/golang/s/NoRedirect", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+	})
+	mux.HandleFunc("/r/golang/s/ToBaseURL", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		// set by NewRequest, i.e. the request went through the client
+		require.Equal(t, mediaTypeJSON, r.Header.Get(headerAccept))
+		http.Redirect(w, r, client.BaseURL.String()+"/r/golang/comments/xyz789/", http.StatusFound)
+	})
+	mux.HandleFunc("/r/golang/comments/xyz789/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("share link redirect was followed")
+	})
+
+	kind, id, err := client.ResolvePermalink(ctx, client.BaseURL.String()+"/r/golang/s/AbCdEf")
+	require.NoError(t, err)
+	require.Equal(t, kindComment, kind)
+	require.Equal(t, "def456", id)
+
+	kind, id, err = client.ResolvePermalink(ctx, client.BaseURL.String()+"/r/golang/s/ToBaseURL")
+	require.NoError(t, err)
+	require.Equal(t, kindPost, kind)
+	require.Equal(t, "xyz789", id)
+
+	_, _, err = client.ResolvePermalink(ctx, client.BaseURL.String()+"/r/golang/s/NoRedirect")
+	require.ErrorIs(t, err, ErrInvalidPermalink)
+}
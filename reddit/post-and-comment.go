@@ -2,9 +2,11 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // postAndCommentService handles communication with the post and comment
@@ -16,15 +18,84 @@ type postAndCommentService struct {
 	client *Client
 }
 
-type vote int
+// VoteDirection is the direction of a vote on a post or a comment.
+type VoteDirection int
 
 // Reddit interprets -1, 0, 1 as downvote, no vote, and upvote, respectively.
 const (
-	downvote vote = iota - 1
-	novote
-	upvote
+	// Downvote downvotes a post or a comment.
+	Downvote VoteDirection = -1
+	// NoVote removes your vote on a post or a comment.
+	NoVote VoteDirection = 0
+	// Upvote upvotes a post or a comment.
+	Upvote VoteDirection = 1
 )
 
+// IsValid reports whether d is one of Downvote, NoVote or Upvote.
+func (d VoteDirection) IsValid() bool {
+	return d == Downvote || d == NoVote || d == Upvote
+}
+
+// The maximum number of full IDs that can be requested at once from api/info.
+const maxInfoIDs = 100
+
+// info returns the things with the provided full IDs.
+// Duplicate IDs are ignored, and the IDs are split into batches to respect the limit
+// imposed by Reddit. The returned response is the one from the last batch.
+func (s *postAndCommentService) info(ctx context.Context, ids []string) (*things, *Response, error) {
+	ids = dedupe(ids)
+
+	result := new(things)
+	result.init()
+
+	var resp *Response
+	for start := 0; start < len(ids); start += maxInfoIDs {
+		end := start + maxInfoIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		type params struct {
+			IDs string `url:"id"`
+		}
+
+		path, err := addOptions("api/info", params{strings.Join(ids[start:end], ",")})
+		if err != nil {
+			return nil, resp, err
+		}
+
+		req, err := s.client.NewRequest(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		root := new(rootListing)
+		resp, err = s.client.Do(ctx, req, root)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		result.Posts = append(result.Posts, root.Data.Things.Posts...)
+		result.Comments = append(result.Comments, root.Data.Things.Comments...)
+	}
+
+	return result, resp, nil
+}
+
+// dedupe returns the strings in the order they first appear, without duplicates.
+func dedupe(values []string) []string {
+	seen := make(set)
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if seen.Exists(v) {
+			continue
+		}
+		seen.Add(v)
+		result = append(result, v)
+	}
+	return result
+}
+
 // Delete deletes a post or comment via its full ID.
 func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Response, error) {
 	path := "api/del"
@@ -70,13 +141,18 @@ func (s *postAndCommentService) Unsave(ctx context.Context, id string) (*Respons
 	return s.client.Do(ctx, req, nil)
 }
 
-// EnableReplies enables inbox replies for one of your posts or comments.
-func (s *postAndCommentService) EnableReplies(ctx context.Context, id string) (*Response, error) {
+// SendReplies sets whether replies to one of your posts or comments are sent to your inbox.
+// The id must be the full ID of the post or comment, e.g. t3_abc123 or t1_abc123.
+func (s *postAndCommentService) SendReplies(ctx context.Context, id string, enabled bool) (*Response, error) {
+	if err := validateFullID(id, kindComment, kindPost); err != nil {
+		return nil, err
+	}
+
 	path := "api/sendreplies"
 
 	form := url.Values{}
 	form.Set("id", id)
-	form.Set("state", "true")
+	form.Set("state", fmt.Sprint(enabled))
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -86,20 +162,14 @@ func (s *postAndCommentService) EnableReplies(ctx context.Context, id string) (*
 	return s.client.Do(ctx, req, nil)
 }
 
+// EnableReplies enables inbox replies for one of your posts or comments.
+func (s *postAndCommentService) EnableReplies(ctx context.Context, id string) (*Response, error) {
+	return s.SendReplies(ctx, id, true)
+}
+
 // DisableReplies dsables inbox replies for one of your posts or comments.
 func (s *postAndCommentService) DisableReplies(ctx context.Context, id string) (*Response, error) {
-	path := "api/sendreplies"
-
-	form := url.Values{}
-	form.Set("id", id)
-	form.Set("state", "false")
-
-	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.SendReplies(ctx, id, false)
 }
 
 // Lock locks a post or comment, preventing it from receiving new comments.
@@ -132,12 +202,18 @@ func (s *postAndCommentService) Unlock(ctx context.Context, id string) (*Respons
 	return s.client.Do(ctx, req, nil)
 }
 
-func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote) (*Response, error) {
+// Vote votes on a post or a comment in the given direction.
+// It returns ErrInvalidVoteDirection if dir is not one of Downvote, NoVote or Upvote.
+func (s *postAndCommentService) Vote(ctx context.Context, id string, dir VoteDirection) (*Response, error) {
+	if !dir.IsValid() {
+		return nil, ErrInvalidVoteDirection
+	}
+
 	path := "api/vote"
 
 	form := url.Values{}
 	form.Set("id", id)
-	form.Set("dir", fmt.Sprint(vote))
+	form.Set("dir", fmt.Sprint(int(dir)))
 	form.Set("rank", "10")
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
@@ -150,17 +226,17 @@ func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote)
 
 // Upvote upvotes a post or a comment.
 func (s *postAndCommentService) Upvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, upvote)
+	return s.Vote(ctx, id, Upvote)
 }
 
 // Downvote downvotes a post or a comment.
 func (s *postAndCommentService) Downvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, downvote)
+	return s.Vote(ctx, id, Downvote)
 }
 
 // RemoveVote removes your vote on a post or a comment.
 func (s *postAndCommentService) RemoveVote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, novote)
+	return s.Vote(ctx, id, NoVote)
 }
 
 // Report reports a post or comment.
@@ -168,8 +244,7 @@ func (s *postAndCommentService) RemoveVote(ctx context.Context, id string) (*Res
 func (s *postAndCommentService) Report(ctx context.Context, id string, reason string) (*Response, error) {
 	path := "api/report"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("thing_id", id)
 	form.Set("reason", reason)
 
@@ -180,3 +255,54 @@ func (s *postAndCommentService) Report(ctx context.Context, id string, reason st
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// AwardOptions are the optional parameters used when giving an award.
+type AwardOptions struct {
+	// If true, the recipient will not know who gave the award.
+	Anonymous bool `json:"is_anonymous"`
+	// A private message sent to the recipient along with the award.
+	Message string `json:"message,omitempty"`
+}
+
+// AwardResult is the result of giving an award.
+type AwardResult struct {
+	// The number of Reddit coins left after giving the award.
+	Coins int `json:"coins"`
+}
+
+// Award gives an award to a post or comment via its full ID.
+// The award is identified by its ID, e.g. gid_1 for silver, gid_2 for gold, gid_3 for platinum.
+// This requires you to own enough Reddit coins and will consume them.
+// If you don't, the error matches ErrInsufficientCoins.
+func (s *postAndCommentService) Award(ctx context.Context, id string, awardID string, opts *AwardOptions) (*AwardResult, *Response, error) {
+	if err := validateFullID(id, kindComment, kindPost); err != nil {
+		return nil, nil, err
+	}
+	if awardID == "" {
+		return nil, nil, errors.New("awardID: cannot be empty")
+	}
+	if opts == nil {
+		opts = new(AwardOptions)
+	}
+
+	path := "api/v2/gold/gild"
+
+	type request struct {
+		ID      string `json:"thing_id"`
+		AwardID string `json:"gild_type"`
+		*AwardOptions
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, &request{id, awardID, opts})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(AwardResult)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -1,14 +1,15 @@
 package reddit
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
-
-	"github.com/google/go-querystring/query"
 )
 
 // PostService handles communication with the post
@@ -62,11 +63,81 @@ type SubmitLinkOptions struct {
 	Spoiler     bool  `url:"spoiler,omitempty"`
 }
 
+// SubmitImageOptions are options used for image posts.
+type SubmitImageOptions struct {
+	Subreddit string `url:"sr,omitempty"`
+	Title     string `url:"title,omitempty"`
+	// The image to upload. It must be a PNG, JPEG, or GIF.
+	Image io.Reader `url:"-"`
+
+	FlairID   string `url:"flair_id,omitempty"`
+	FlairText string `url:"flair_text,omitempty"`
+
+	SendReplies *bool `url:"sendreplies,omitempty"`
+	NSFW        bool  `url:"nsfw,omitempty"`
+	Spoiler     bool  `url:"spoiler,omitempty"`
+}
+
+// SubmitGalleryItem is an image in a gallery post.
+type SubmitGalleryItem struct {
+	// The image to upload. It must be a PNG, JPEG, or GIF.
+	Image io.Reader
+	// Optional.
+	Caption string
+	// Optional. A URL the image links to.
+	OutboundURL string
+}
+
+// SubmitGalleryOptions are options used for gallery posts.
+type SubmitGalleryOptions struct {
+	Subreddit string `json:"sr"`
+	Title     string `json:"title"`
+	// Must contain between 2 and 20 (inclusive) items.
+	Items []SubmitGalleryItem `json:"-"`
+
+	FlairID   string `json:"flair_id,omitempty"`
+	FlairText string `json:"flair_text,omitempty"`
+
+	SendReplies *bool `json:"sendreplies,omitempty"`
+	NSFW        bool  `json:"nsfw"`
+	Spoiler     bool  `json:"spoiler"`
+}
+
+// SubmitPollOptions are options used for poll posts.
+type SubmitPollOptions struct {
+	Subreddit string `json:"sr"`
+	Title     string `json:"title"`
+	// Optional. Text displayed above the poll.
+	Text string `json:"text,omitempty"`
+	// Must contain between 2 and 6 (inclusive) options.
+	Options []string `json:"options"`
+	// The number of days the poll is open for. Must be between 1 and 7 (inclusive).
+	Duration int `json:"duration"`
+
+	FlairID   string `json:"flair_id,omitempty"`
+	FlairText string `json:"flair_text,omitempty"`
+
+	SendReplies *bool `json:"sendreplies,omitempty"`
+	NSFW        bool  `json:"nsfw"`
+	Spoiler     bool  `json:"spoiler"`
+}
+
 // Get returns a post with its comments.
 // id is the ID36 of the post, not its full id.
 // Example: instead of t3_abc123, use abc123.
 func (s *PostService) Get(ctx context.Context, id string) (*PostAndComments, *Response, error) {
-	path := fmt.Sprintf("comments/%s", id)
+	return s.GetComments(ctx, id, nil)
+}
+
+// GetComments returns a post with its comments, sorted and limited according to opts.
+// postID is the ID36 of the post, e.g. abc123, not its full ID.
+func (s *PostService) GetComments(ctx context.Context, postID string, opts *GetCommentsOptions) (*PostAndComments, *Response, error) {
+	path := fmt.Sprintf("comments/%s", postID)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -81,6 +152,34 @@ func (s *PostService) Get(ctx context.Context, id string) (*PostAndComments, *Re
 	return root, resp, nil
 }
 
+// GetMultiple returns posts from their full IDs, in the order in which the IDs were provided.
+// Duplicate IDs are ignored, and posts that could not be found are left out.
+// Requests are made in batches of 100 IDs, which is the limit imposed by Reddit.
+func (s *PostService) GetMultiple(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
+	if len(ids) == 0 {
+		return nil, nil, errors.New("must provide at least 1 id")
+	}
+
+	things, resp, err := s.info(ctx, ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	postMap := make(map[string]*Post, len(things.Posts))
+	for _, post := range things.Posts {
+		postMap[post.FullID] = post
+	}
+
+	var posts []*Post
+	for _, id := range dedupe(ids) {
+		if post, ok := postMap[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+
+	return posts, resp, nil
+}
+
 // Duplicates returns the post with the id, and a list of its duplicates.
 // id is the ID36 of the post, not its full id.
 // Example: instead of t3_abc123, use abc123.
@@ -111,11 +210,10 @@ func (s *PostService) Duplicates(ctx context.Context, id string, opts *ListDupli
 func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *Response, error) {
 	path := "api/submit"
 
-	form, err := query.Values(v)
+	form, err := encodeJSONForm(v)
 	if err != nil {
 		return nil, nil, err
 	}
-	form.Set("api_type", "json")
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -131,6 +229,20 @@ func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *R
 	return root.JSON.Data, resp, nil
 }
 
+// GetFlairChoices returns the post flairs that can be chosen when submitting a post to the subreddit,
+// along with the currently selected one, if any. The ID of a choice can be used as the FlairID
+// of the submit options.
+func (s *PostService) GetFlairChoices(ctx context.Context, subreddit string) (*FlairSelector, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	form := url.Values{}
+	form.Set("is_newlink", "true")
+
+	return s.client.Flair.selector(ctx, subreddit, form)
+}
+
 // SubmitText submits a text post.
 func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextOptions) (*Submitted, *Response, error) {
 	type submit struct {
@@ -149,12 +261,172 @@ func (s *PostService) SubmitLink(ctx context.Context, opts SubmitLinkOptions) (*
 	return s.submit(ctx, &submit{opts, "link"})
 }
 
+// uploadMedia uploads the image to Reddit via an upload lease.
+// It returns the ID of the uploaded asset and its URL.
+func (s *PostService) uploadMedia(ctx context.Context, image io.Reader) (string, string, *Response, error) {
+	if image == nil {
+		return "", "", nil, errors.New("image: cannot be nil")
+	}
+
+	data, err := ioutil.ReadAll(image)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	mimetype := http.DetectContentType(data)
+	var filename string
+	switch mimetype {
+	case "image/png":
+		filename = "image.png"
+	case "image/jpeg":
+		filename = "image.jpg"
+	case "image/gif":
+		filename = "image.gif"
+	default:
+		return "", "", nil, fmt.Errorf("image: must be a PNG, JPEG, or GIF, got %s", mimetype)
+	}
+
+	path := "api/media/asset.json"
+
+	form := url.Values{}
+	form.Set("filepath", filename)
+	form.Set("mimetype", mimetype)
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	root := new(struct {
+		Args struct {
+			Action string `json:"action"`
+			Fields []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"fields"`
+		} `json:"args"`
+		Asset struct {
+			ID string `json:"asset_id"`
+		} `json:"asset"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", "", resp, err
+	}
+
+	uploadURL, err := s.client.leaseURL(root.Args.Action)
+	if err != nil {
+		return "", "", resp, err
+	}
+
+	fields := make(map[string]string)
+	for _, field := range root.Args.Fields {
+		fields[field.Name] = field.Value
+	}
+
+	resp, err = s.client.uploadToLease(ctx, uploadURL, fields, filename, bytes.NewReader(data))
+	if err != nil {
+		return "", "", resp, err
+	}
+
+	return root.Asset.ID, fmt.Sprintf("%s/%s", uploadURL, fields["key"]), resp, nil
+}
+
+// SubmitImage uploads an image and submits it as an image post.
+// Reddit processes image posts asynchronously, so the returned post might not have an ID yet.
+func (s *PostService) SubmitImage(ctx context.Context, opts SubmitImageOptions) (*Submitted, *Response, error) {
+	_, imageURL, resp, err := s.uploadMedia(ctx, opts.Image)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	type submit struct {
+		SubmitImageOptions
+		Kind string `url:"kind,omitempty"`
+		URL  string `url:"url,omitempty"`
+	}
+	return s.submit(ctx, &submit{opts, "image", imageURL})
+}
+
+// SubmitGallery uploads the images and submits them as a gallery post.
+func (s *PostService) SubmitGallery(ctx context.Context, opts SubmitGalleryOptions) (*Submitted, *Response, error) {
+	if len(opts.Items) < 2 || len(opts.Items) > 20 {
+		return nil, nil, errors.New("items: must have between 2 and 20 items (inclusive)")
+	}
+
+	type item struct {
+		MediaID     string `json:"media_id"`
+		Caption     string `json:"caption"`
+		OutboundURL string `json:"outbound_url"`
+	}
+
+	items := make([]item, 0, len(opts.Items))
+	for _, galleryItem := range opts.Items {
+		assetID, _, resp, err := s.uploadMedia(ctx, galleryItem.Image)
+		if err != nil {
+			return nil, resp, err
+		}
+		items = append(items, item{assetID, galleryItem.Caption, galleryItem.OutboundURL})
+	}
+
+	type request struct {
+		*SubmitGalleryOptions
+		APIType string `json:"api_type"`
+		Items   []item `json:"items"`
+	}
+	return s.submitJSON(ctx, "api/submit_gallery_post.json", &request{&opts, "json", items})
+}
+
+// SubmitPoll submits a poll post.
+func (s *PostService) SubmitPoll(ctx context.Context, opts SubmitPollOptions) (*Submitted, *Response, error) {
+	if len(opts.Options) < 2 || len(opts.Options) > 6 {
+		return nil, nil, errors.New("options: must have between 2 and 6 options (inclusive)")
+	}
+	if opts.Duration < 1 || opts.Duration > 7 {
+		return nil, nil, errors.New("duration: must be between 1 and 7 days (inclusive)")
+	}
+
+	type request struct {
+		*SubmitPollOptions
+		APIType string `json:"api_type"`
+	}
+	return s.submitJSON(ctx, "api/submit_poll_post.json", &request{&opts, "json"})
+}
+
+// submitJSON submits a post whose options are sent as JSON, e.g. galleries and polls.
+func (s *PostService) submitJSON(ctx context.Context, path string, body interface{}) (*Submitted, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				FullID string `json:"id"`
+				URL    string `json:"url"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	submitted := &Submitted{
+		ID:     strings.TrimPrefix(root.JSON.Data.FullID, kindPost+"_"),
+		FullID: root.JSON.Data.FullID,
+		URL:    root.JSON.Data.URL,
+	}
+
+	return submitted, resp, nil
+}
+
 // Edit edits a post.
 func (s *PostService) Edit(ctx context.Context, id string, text string) (*Post, *Response, error) {
 	path := "api/editusertext"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("return_rtjson", "true")
 	form.Set("thing_id", id)
 	form.Set("text", text)
@@ -278,8 +550,7 @@ func (s *PostService) Unspoiler(ctx context.Context, id string) (*Response, erro
 func (s *PostService) Sticky(ctx context.Context, id string, bottom bool) (*Response, error) {
 	path := "api/set_subreddit_sticky"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "true")
 	if !bottom {
@@ -298,8 +569,7 @@ func (s *PostService) Sticky(ctx context.Context, id string, bottom bool) (*Resp
 func (s *PostService) Unsticky(ctx context.Context, id string) (*Response, error) {
 	path := "api/set_subreddit_sticky"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "false")
 
@@ -328,8 +598,7 @@ func (s *PostService) PinToProfile(ctx context.Context, id string) (*Response, e
 	// 	pos = 4
 	// }
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "true")
 	form.Set("to_profile", "true")
@@ -347,8 +616,7 @@ func (s *PostService) PinToProfile(ctx context.Context, id string) (*Response, e
 func (s *PostService) UnpinFromProfile(ctx context.Context, id string) (*Response, error) {
 	path := "api/set_subreddit_sticky"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "false")
 	form.Set("to_profile", "true")
@@ -366,8 +634,7 @@ func (s *PostService) UnpinFromProfile(ctx context.Context, id string) (*Respons
 func (s *PostService) setSuggestedSort(ctx context.Context, id string, sort string) (*Response, error) {
 	path := "api/set_suggested_sort"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("sort", sort)
 
@@ -430,8 +697,7 @@ func (s *PostService) ClearSuggestedSort(ctx context.Context, id string) (*Respo
 func (s *PostService) EnableContestMode(ctx context.Context, id string) (*Response, error) {
 	path := "api/set_contest_mode"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "true")
 
@@ -447,8 +713,7 @@ func (s *PostService) EnableContestMode(ctx context.Context, id string) (*Respon
 func (s *PostService) DisableContestMode(ctx context.Context, id string) (*Response, error) {
 	path := "api/set_contest_mode"
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("id", id)
 	form.Set("state", "false")
 
@@ -473,8 +738,7 @@ func (s *PostService) LoadMoreComments(ctx context.Context, pc *PostAndComments)
 	postID := pc.Post.FullID
 	commentIDs := pc.More.Children
 
-	form := url.Values{}
-	form.Set("api_type", "json")
+	form := newJSONForm()
 	form.Set("link_id", postID)
 	form.Set("children", strings.Join(commentIDs, ","))
 
@@ -557,12 +821,24 @@ func (s *PostService) RandomFromSubscriptions(ctx context.Context) (*PostAndComm
 	return s.random(ctx)
 }
 
-// MarkVisited marks the post(s) as visited.
-// This method requires a subscription to Reddit premium.
+// maxVisitIDs is the maximum number of posts that can be marked as visited in a single request.
+const maxVisitIDs = 50
+
+// MarkVisited marks the post(s) as visited, given their full IDs, e.g. t3_abc123.
+// At most 50 posts can be marked at once.
+// This method requires a subscription to Reddit premium; otherwise the error matches ErrGoldRequired.
 func (s *PostService) MarkVisited(ctx context.Context, ids ...string) (*Response, error) {
 	if len(ids) == 0 {
 		return nil, errors.New("must provide at least 1 id")
 	}
+	if len(ids) > maxVisitIDs {
+		return nil, fmt.Errorf("cannot provide more than %d ids, got %d", maxVisitIDs, len(ids))
+	}
+	for _, id := range ids {
+		if err := validateFullID(id, kindPost); err != nil {
+			return nil, err
+		}
+	}
 
 	path := "api/store_visits"
 
@@ -574,5 +850,9 @@ func (s *PostService) MarkVisited(ctx context.Context, ids ...string) (*Response
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if errors.Is(err, ErrForbidden) {
+		return resp, fmt.Errorf("%w: %w", ErrGoldRequired, err)
+	}
+	return resp, err
 }
@@ -1,9 +1,14 @@
 package reddit
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,10 +20,10 @@ var expectedPostAndComments = &PostAndComments{
 		ID:      "testpost",
 		FullID:  "t3_testpost",
 		Created: &Timestamp{time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/testpost/test/",
 		URL:       "https://www.reddit.com/r/test/comments/testpost/test/",
+		Thumbnail: "self",
 
 		Title: "Test",
 		Body:  "Hello",
@@ -34,6 +39,8 @@ var expectedPostAndComments = &PostAndComments{
 		Author:   "testuser",
 		AuthorID: "t2_testuser",
 
+		WhitelistStatus: "all_ads",
+
 		IsSelfPost: true,
 	},
 	Comments: []*Comment{
@@ -41,12 +48,12 @@ var expectedPostAndComments = &PostAndComments{
 			ID:      "testc1",
 			FullID:  "t1_testc1",
 			Created: &Timestamp{time.Date(2020, 7, 18, 10, 31, 59, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			ParentID:  "t3_testpost",
 			Permalink: "/r/test/comments/testpost/test/testc1/",
 
 			Body:     "Hi",
+			BodyHTML: "&lt;div class=\"md\"&gt;&lt;p&gt;Hi&lt;/p&gt;\n&lt;/div&gt;",
 			Author:   "testuser",
 			AuthorID: "t2_testuser",
 
@@ -68,12 +75,12 @@ var expectedPostAndComments = &PostAndComments{
 						ID:      "testc2",
 						FullID:  "t1_testc2",
 						Created: &Timestamp{time.Date(2020, 7, 18, 10, 32, 28, 0, time.UTC)},
-						Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 						ParentID:  "t1_testc1",
 						Permalink: "/r/test/comments/testpost/test/testc2/",
 
 						Body:     "Hello",
+						BodyHTML: "&lt;div class=\"md\"&gt;&lt;p&gt;Hello&lt;/p&gt;\n&lt;/div&gt;",
 						Author:   "testuser",
 						AuthorID: "t2_testuser",
 
@@ -83,6 +90,7 @@ var expectedPostAndComments = &PostAndComments{
 
 						Score:            1,
 						Controversiality: 0,
+						Depth:            1,
 
 						PostID: "t3_testpost",
 
@@ -109,6 +117,7 @@ var expectedEditedPost = &Post{
 
 	Permalink: "/r/test/comments/hw6l6a/test_title/",
 	URL:       "https://www.reddit.com/r/test/comments/hw6l6a/test_title/",
+	Thumbnail: "spoiler",
 
 	Title: "Test Title",
 	Body:  "this is edited",
@@ -126,6 +135,8 @@ var expectedEditedPost = &Post{
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
 
+	WhitelistStatus: "all_ads",
+
 	Spoiler:    true,
 	IsSelfPost: true,
 }
@@ -134,10 +145,10 @@ var expectedPost2 = &Post{
 	ID:      "i2gvs1",
 	FullID:  "t3_i2gvs1",
 	Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 	URL:       "http://example.com",
+	Thumbnail: "default",
 
 	Title: "This is a title",
 
@@ -153,6 +164,8 @@ var expectedPost2 = &Post{
 
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
+
+	WhitelistStatus: "all_ads",
 }
 
 var expectedPostDuplicates = &Posts{
@@ -161,10 +174,10 @@ var expectedPostDuplicates = &Posts{
 			ID:      "8kbs85",
 			FullID:  "t3_8kbs85",
 			Created: &Timestamp{time.Date(2018, 5, 18, 9, 10, 18, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/test/comments/8kbs85/test/",
 			URL:       "http://example.com",
+			Thumbnail: "default",
 
 			Title: "test",
 
@@ -180,15 +193,17 @@ var expectedPostDuplicates = &Posts{
 
 			Author:   "GarlicoinAccount",
 			AuthorID: "t2_d2v1r90",
+
+			WhitelistStatus: "all_ads",
 		},
 		{
 			ID:      "le1tc",
 			FullID:  "t3_le1tc",
 			Created: &Timestamp{time.Date(2011, 10, 16, 13, 26, 40, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/test/comments/le1tc/test_to_see_if_this_fixes_the_problem_of_my_likes/",
 			URL:       "http://www.example.com",
+			Thumbnail: "default",
 
 			Title: "Test to see if this fixes the problem of my \"likes\" from the last 7 months vanishing.",
 
@@ -204,6 +219,8 @@ var expectedPostDuplicates = &Posts{
 
 			Author:   "prog101",
 			AuthorID: "t2_8dyo",
+
+			WhitelistStatus: "all_ads",
 		},
 	},
 	After:  "t3_le1tc",
@@ -227,6 +244,118 @@ func TestPostService_Get(t *testing.T) {
 	require.Equal(t, expectedPostAndComments, postAndComments)
 }
 
+func TestPostService_GetComments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("sort", "qa")
+		form.Set("depth", "3")
+		form.Set("limit", "200")
+		form.Set("comment", "def456")
+		form.Set("showedits", "false")
+		form.Set("threaded", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	postAndComments, _, err := client.Post.GetComments(ctx, "abc123", &GetCommentsOptions{
+		Sort:      CommentSortQA,
+		Depth:     3,
+		Limit:     200,
+		CommentID: "def456",
+		ShowEdits: Bool(false),
+		Threaded:  Bool(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+}
+
+func TestPostService_GetComments_InvalidOptions(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Post.GetComments(ctx, "abc123", &GetCommentsOptions{Depth: 11})
+	require.EqualError(t, err, "depth: must be between 1 and 10 (inclusive), got 11")
+
+	_, _, err = client.Post.GetComments(ctx, "abc123", &GetCommentsOptions{Depth: -1})
+	require.EqualError(t, err, "depth: must be between 1 and 10 (inclusive), got -1")
+
+	_, _, err = client.Post.GetComments(ctx, "abc123", &GetCommentsOptions{Limit: 501})
+	require.EqualError(t, err, "limit: must be between 0 (default) and 500 (inclusive), got 501")
+
+	_, _, err = client.Post.GetComments(ctx, "abc123", &GetCommentsOptions{Sort: "best"})
+	require.EqualError(t, err, `sort: unknown comment sort "best"`)
+}
+
+func TestPostService_GetMultiple(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t3_i2gvs1,t3_i2gvg4")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.GetMultiple(ctx)
+	require.EqualError(t, err, "must provide at least 1 id")
+
+	posts, _, err := client.Post.GetMultiple(ctx, "t3_i2gvs1", "t3_i2gvg4", "t3_i2gvs1")
+	require.NoError(t, err)
+	require.Equal(t, []*Post{expectedListingPosts2[1], expectedListingPosts2[0]}, posts)
+}
+
+func TestPostService_GetMultiple_Batches(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	var ids []string
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprintf("t3_%d", i))
+	}
+	ids = append(ids, "t3_i2gvg4")
+
+	var batches []int
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		batches = append(batches, len(strings.Split(r.Form.Get("id"), ",")))
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Post.GetMultiple(ctx, ids...)
+	require.NoError(t, err)
+	require.Equal(t, []int{100, 51}, batches)
+	require.Equal(t, []*Post{expectedListingPosts2[0]}, posts)
+}
+
 func TestPostService_Duplicates(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -259,6 +388,66 @@ func TestPostService_Duplicates(t *testing.T) {
 	require.Equal(t, expectedPostDuplicates, postDuplicates)
 }
 
+func TestPostService_GetFlairChoices(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/post/flair-choices.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/api/flairselector", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("is_newlink", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.GetFlairChoices(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	selector, _, err := client.Post.GetFlairChoices(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, &Flair{
+		ID:              "4c2d7e0a-1b3f-11eb-9a6c-0e4f2a7b8c91",
+		Text:            "Discussion",
+		CSSClass:        "discussion",
+		Color:           "light",
+		BackgroundColor: "#0079d3",
+	}, selector.Current)
+	require.Equal(t, []*Flair{
+		{
+			ID:               "4c2d7e0a-1b3f-11eb-9a6c-0e4f2a7b8c91",
+			Text:             "Discussion",
+			CSSClass:         "discussion",
+			Color:            "light",
+			BackgroundColor:  "#0079d3",
+			AllowableContent: "all",
+		},
+		{
+			ID:               "5d3e8f1b-1b3f-11eb-8b7d-0e4f2a7b8c91",
+			Text:             "Question",
+			CSSClass:         "question",
+			Color:            "dark",
+			BackgroundColor:  "#ffd635",
+			AllowableContent: "text",
+		},
+		{
+			ID:               "6e4f9a2c-1b3f-11eb-a18e-0e4f2a7b8c91",
+			Text:             "Other",
+			Color:            "dark",
+			Editable:         true,
+			ModOnly:          true,
+			AllowableContent: "emoji",
+		},
+	}, selector.Choices)
+}
+
 func TestPostService_SubmitText(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -1025,6 +1214,34 @@ func TestPostService_DisableReplies(t *testing.T) {
 	require.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func TestPostService_SendReplies(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var state string
+	mux.HandleFunc("/api/sendreplies", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t1_test", r.PostForm.Get("id"))
+		state = r.PostForm.Get("state")
+	})
+
+	_, err := client.Post.SendReplies(ctx, "t1_test", true)
+	require.NoError(t, err)
+	require.Equal(t, "true", state)
+
+	_, err = client.Post.SendReplies(ctx, "t1_test", false)
+	require.NoError(t, err)
+	require.Equal(t, "false", state)
+
+	for _, id := range []string{"", "test", "t2_test", "t3_"} {
+		_, err = client.Post.SendReplies(ctx, id, true)
+		require.True(t, errors.Is(err, ErrInvalidFullID))
+	}
+}
+
 func TestPostService_Lock(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -1131,6 +1348,34 @@ func TestPostService_RemoveVote(t *testing.T) {
 	require.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func TestPostService_Vote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var dirs []string
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t3_test", r.PostForm.Get("id"))
+		dirs = append(dirs, r.PostForm.Get("dir"))
+	})
+
+	for _, dir := range []VoteDirection{Upvote, NoVote, Downvote} {
+		_, err := client.Post.Vote(ctx, "t3_test", dir)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"1", "0", "-1"}, dirs)
+
+	_, err := client.Post.Vote(ctx, "t3_test", VoteDirection(2))
+	require.Equal(t, ErrInvalidVoteDirection, err)
+
+	_, err = client.Post.Vote(ctx, "t3_test", VoteDirection(-2))
+	require.Equal(t, ErrInvalidVoteDirection, err)
+	require.Len(t, dirs, 3)
+}
+
 func TestPostService_MarkVisited(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -1149,10 +1394,34 @@ func TestPostService_MarkVisited(t *testing.T) {
 	_, err := client.Post.MarkVisited(ctx)
 	require.EqualError(t, err, "must provide at least 1 id")
 
+	_, err = client.Post.MarkVisited(ctx, "t3_test1", "t1_test2")
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	ids := make([]string, 51)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("t3_test%d", i)
+	}
+	_, err = client.Post.MarkVisited(ctx, ids...)
+	require.EqualError(t, err, "cannot provide more than 50 ids, got 51")
+
 	_, err = client.Post.MarkVisited(ctx, "t3_test1", "t3_test2", "t3_test3")
 	require.NoError(t, err)
 }
 
+func TestPostService_MarkVisited_GoldRequired(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/store_visits", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Post.MarkVisited(ctx, "t3_test1")
+	require.ErrorIs(t, err, ErrGoldRequired)
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
 func TestPostService_Report(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -1173,3 +1442,241 @@ func TestPostService_Report(t *testing.T) {
 	_, err := client.Post.Report(ctx, "t3_test", "test reason")
 	require.NoError(t, err)
 }
+
+func TestPostService_Award(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"thing_id":     "t3_test",
+			"gild_type":    "gid_2",
+			"is_anonymous": true,
+			"message":      "thanks!",
+		}, body)
+
+		fmt.Fprint(w, `{"coins": 1300}`)
+	})
+
+	_, _, err := client.Post.Award(ctx, "t5_test", "gid_2", nil)
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+
+	_, _, err = client.Post.Award(ctx, "t3_test", "", nil)
+	require.EqualError(t, err, "awardID: cannot be empty")
+
+	result, _, err := client.Post.Award(ctx, "t3_test", "gid_2", &AwardOptions{Anonymous: true, Message: "thanks!"})
+	require.NoError(t, err)
+	require.Equal(t, &AwardResult{Coins: 1300}, result)
+}
+
+const testPNG = "\x89PNG\r\n\x1a\nthis is a test"
+
+// handleMediaUploads sets up the endpoints used to upload media.
+// Each upload gets a new asset ID, i.e. asset1, asset2, etc.
+func handleMediaUploads(t *testing.T, client *Client, mux *http.ServeMux) {
+	uploadURL := client.BaseURL.Host + "/api/media_upload"
+
+	blob, err := readFileContents("../testdata/post/media-lease.json")
+	require.NoError(t, err)
+
+	var count int
+	mux.HandleFunc("/api/media/asset.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("filepath", "image.png")
+		form.Set("mimetype", "image/png")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		count++
+		assetID := fmt.Sprintf("asset%d", count)
+		fmt.Fprintf(w, blob, uploadURL, assetID, assetID)
+	})
+
+	mux.HandleFunc("/api/media_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		_, file, err := r.FormFile("file")
+		require.NoError(t, err)
+
+		rdr, err := file.Open()
+		require.NoError(t, err)
+
+		buf := new(bytes.Buffer)
+		_, err = io.Copy(buf, rdr)
+		require.NoError(t, err)
+		require.Equal(t, testPNG, buf.String())
+
+		err = r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("rte_images/asset%d.png", count), r.Form.Get("key"))
+		require.Equal(t, "test value", r.Form.Get("test name"))
+	})
+}
+
+func TestPostService_SubmitImage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handleMediaUploads(t, client, mux)
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "image")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("url", fmt.Sprintf("http://%s/api/media_upload/rte_images/asset1.png", client.BaseURL.Host))
+		form.Set("nsfw", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitImage(ctx, SubmitImageOptions{Subreddit: "test", Title: "Test Title"})
+	require.EqualError(t, err, "image: cannot be nil")
+
+	_, _, err = client.Post.SubmitImage(ctx, SubmitImageOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Image:     strings.NewReader("not an image"),
+	})
+	require.EqualError(t, err, "image: must be a PNG, JPEG, or GIF, got text/plain; charset=utf-8")
+
+	submittedPost, _, err := client.Post.SubmitImage(ctx, SubmitImageOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Image:     strings.NewReader(testPNG),
+		NSFW:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
+func TestPostService_SubmitGallery(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handleMediaUploads(t, client, mux)
+
+	blob, err := readFileContents("../testdata/post/submit-gallery.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit_gallery_post.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"api_type": "json",
+			"sr":       "test",
+			"title":    "Test Title",
+			"nsfw":     false,
+			"spoiler":  true,
+			"items": []interface{}{
+				map[string]interface{}{"media_id": "asset1", "caption": "first", "outbound_url": ""},
+				map[string]interface{}{"media_id": "asset2", "caption": "", "outbound_url": "https://www.example.com"},
+			},
+		}, body)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitGallery(ctx, SubmitGalleryOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Items:     []SubmitGalleryItem{{Image: strings.NewReader(testPNG)}},
+	})
+	require.EqualError(t, err, "items: must have between 2 and 20 items (inclusive)")
+
+	submittedPost, _, err := client.Post.SubmitGallery(ctx, SubmitGalleryOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Items: []SubmitGalleryItem{
+			{Image: strings.NewReader(testPNG), Caption: "first"},
+			{Image: strings.NewReader(testPNG), OutboundURL: "https://www.example.com"},
+		},
+		Spoiler: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{
+		ID:     "hw6l6a",
+		FullID: "t3_hw6l6a",
+		URL:    "https://www.reddit.com/gallery/hw6l6a",
+	}, submittedPost)
+}
+
+func TestPostService_SubmitPoll(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/post/submit-poll.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit_poll_post.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"api_type": "json",
+			"sr":       "test",
+			"title":    "Test Title",
+			"text":     "Test Text",
+			"options":  []interface{}{"yes", "no", "maybe"},
+			"duration": float64(3),
+			"nsfw":     false,
+			"spoiler":  false,
+		}, body)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Options:   []string{"yes"},
+		Duration:  3,
+	})
+	require.EqualError(t, err, "options: must have between 2 and 6 options (inclusive)")
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Options:   []string{"yes", "no"},
+		Duration:  8,
+	})
+	require.EqualError(t, err, "duration: must be between 1 and 7 days (inclusive)")
+
+	submittedPost, _, err := client.Post.SubmitPoll(ctx, SubmitPollOptions{
+		Subreddit: "test",
+		Title:     "Test Title",
+		Text:      "Test Text",
+		Options:   []string{"yes", "no", "maybe"},
+		Duration:  3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{
+		ID:     "hw6l6b",
+		FullID: "t3_hw6l6b",
+		URL:    "https://www.reddit.com/r/test/comments/hw6l6b/test_title/",
+	}, submittedPost)
+}
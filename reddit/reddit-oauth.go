@@ -38,6 +38,7 @@ import (
 	"net/http"
 
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 type oauthTokenSource struct {
@@ -47,6 +48,16 @@ type oauthTokenSource struct {
 }
 
 func (s *oauthTokenSource) Token() (*oauth2.Token, error) {
+	// without a user, the client can only use application-only authentication
+	if s.username == "" && s.password == "" {
+		config := &clientcredentials.Config{
+			ClientID:     s.config.ClientID,
+			ClientSecret: s.config.ClientSecret,
+			TokenURL:     s.config.Endpoint.TokenURL,
+			AuthStyle:    s.config.Endpoint.AuthStyle,
+		}
+		return config.Token(s.ctx)
+	}
 	return s.config.PasswordCredentialsToken(s.ctx, s.username, s.password)
 }
 
@@ -78,8 +89,28 @@ func oauthTransport(client *Client) http.RoundTripper {
 		password: client.Password,
 	})
 
-	return &oauth2.Transport{
-		Source: tokenSource,
-		Base:   userAgentTransport,
+	return &apiAuthTransport{
+		client: client,
+		auth: &oauth2.Transport{
+			Source: tokenSource,
+			Base:   userAgentTransport,
+		},
+		Base: userAgentTransport,
+	}
+}
+
+// apiAuthTransport only authenticates requests sent to the API's host, so the access token
+// is not leaked to other hosts, e.g. the storage of an upload lease.
+// The host is read from the client's BaseURL on every request, so changing it takes effect.
+type apiAuthTransport struct {
+	client *Client
+	auth   http.RoundTripper
+	Base   http.RoundTripper
+}
+
+func (t *apiAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == t.client.BaseURL.Host {
+		return t.auth.RoundTrip(req)
 	}
+	return t.Base.RoundTrip(req)
 }
@@ -1,8 +1,16 @@
 package reddit
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Opt is a configuration option to initialize a client.
@@ -35,19 +43,109 @@ func FromEnv(c *Client) error {
 	return nil
 }
 
+// WithCredentialsFromEnv authenticates the client with credentials read from environment variables:
+//
+// REDDIT_CLIENT_ID to set the client's id (required).
+// REDDIT_CLIENT_SECRET to set the client's secret (required).
+// REDDIT_USERNAME to set the client's username.
+// REDDIT_PASSWORD to set the client's password.
+//
+// If neither REDDIT_USERNAME nor REDDIT_PASSWORD is set, the client uses application-only
+// authentication, i.e. it does not act on behalf of a user.
+// The credentials are only used if no credentials are passed to NewClient.
+func WithCredentialsFromEnv() Opt {
+	return func(c *Client) error {
+		creds := &Credentials{
+			ID:       os.Getenv("REDDIT_CLIENT_ID"),
+			Secret:   os.Getenv("REDDIT_CLIENT_SECRET"),
+			Username: os.Getenv("REDDIT_USERNAME"),
+			Password: os.Getenv("REDDIT_PASSWORD"),
+		}
+
+		switch {
+		case creds.ID == "":
+			return errors.New("REDDIT_CLIENT_ID: environment variable is not set")
+		case creds.Secret == "":
+			return errors.New("REDDIT_CLIENT_SECRET: environment variable is not set")
+		case creds.Username == "" && creds.Password != "":
+			return errors.New("REDDIT_USERNAME: environment variable is not set, it is required when REDDIT_PASSWORD is set")
+		case creds.Username != "" && creds.Password == "":
+			return errors.New("REDDIT_PASSWORD: environment variable is not set, it is required when REDDIT_USERNAME is set")
+		}
+
+		c.credentials = creds
+		return nil
+	}
+}
+
 // WithBaseURL sets the base URL for the client to make requests to.
+// It must be an absolute URL, e.g. https://oauth.reddit.com or the URL of an httptest.Server.
+// If it has a path, requests are made relative to it.
 func WithBaseURL(u string) Opt {
 	return func(c *Client) error {
 		url, err := url.Parse(u)
 		if err != nil {
 			return err
 		}
+		if url.Host == "" {
+			return fmt.Errorf("baseURL: %q must have a host", u)
+		}
+
+		// without a trailing slash, the last segment of the path would be replaced when resolving paths against it
+		if url.Path != "" && !strings.HasSuffix(url.Path, "/") {
+			url.Path += "/"
+		}
 
 		c.BaseURL = url
 		return nil
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with the client's requests.
+//
+// Reddit requires a unique and descriptive user agent, in the following format:
+//
+//	<platform>:<app ID>:<version string> (by /u/<reddit username>)
+//
+// e.g. "linux:com.example.mybot:v1.2.0 (by /u/example)". Generic user agents are heavily rate limited,
+// so the user agent must not be empty, must contain a slash and must not be the default one of an HTTP library.
+// If a logger is set with WithLogger, a warning is logged if it looks like a browser's user agent.
+// See https://github.com/reddit-archive/reddit/wiki/API.
+func WithUserAgent(ua string) Opt {
+	return func(c *Client) error {
+		if err := validateUserAgent(ua); err != nil {
+			return err
+		}
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// genericUserAgents are the prefixes of the default user agents of common HTTP libraries.
+var genericUserAgents = []string{"go-http-client/", "curl/", "python-requests/", "python-urllib/", "wget/", "okhttp/"}
+
+func validateUserAgent(ua string) error {
+	ua = strings.TrimSpace(ua)
+	if ua == "" {
+		return errors.New("userAgent: cannot be empty")
+	}
+	if !strings.Contains(ua, "/") {
+		return fmt.Errorf("userAgent: %q must contain a slash, e.g. platform:app_id:version (by /u/username)", ua)
+	}
+	lower := strings.ToLower(ua)
+	for _, prefix := range genericUserAgents {
+		if strings.HasPrefix(lower, prefix) {
+			return fmt.Errorf("userAgent: %q is a generic user agent, use the format platform:app_id:version (by /u/username)", ua)
+		}
+	}
+	return nil
+}
+
+// isBrowserUserAgent reports whether the user agent looks like a browser's.
+func isBrowserUserAgent(ua string) bool {
+	return strings.HasPrefix(ua, "Mozilla/")
+}
+
 // WithTokenURL sets the url used to get access tokens.
 func WithTokenURL(u string) Opt {
 	return func(c *Client) error {
@@ -60,3 +158,94 @@ func WithTokenURL(u string) Opt {
 		return nil
 	}
 }
+
+// WithTimeout sets the maximum amount of time each request made by the client can take.
+// The timeout covers the whole request, including reading the response body.
+// If the context passed to a method already has an earlier deadline, that deadline is kept.
+// A duration of 0 or less means there is no timeout, which is the default.
+// See WithDefaultTimeout for how the two options combine.
+func WithTimeout(d time.Duration) Opt {
+	return func(c *Client) error {
+		c.timeout = d
+		return nil
+	}
+}
+
+// WithDefaultTimeout sets a timeout for the requests whose context has no deadline,
+// so that a slow request cannot block indefinitely. Unlike WithTimeout, a deadline set by the
+// caller is always kept as is, even if it is later.
+// A duration of 0 or less means there is no default timeout, which is the default.
+//
+// If WithTimeout is set as well, a request whose context has no deadline is cancelled after the
+// shorter of the two durations, and a request whose context has a deadline is only subject to WithTimeout.
+func WithDefaultTimeout(d time.Duration) Opt {
+	return func(c *Client) error {
+		c.defaultTimeout = d
+		return nil
+	}
+}
+
+// WithRateLimiter throttles the client's requests to rps requests per second on average, allowing
+// bursts of up to burst requests. Requests wait in Do until they are allowed, or until their context
+// is done, in which case its error is returned. The limiter is shared by every goroutine using the client.
+func WithRateLimiter(rps float64, burst int) Opt {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return errors.New("rps: must be greater than 0")
+		}
+		if burst <= 0 {
+			return errors.New("burst: must be greater than 0")
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		return nil
+	}
+}
+
+// WithReadOnly makes the client refuse to send any request that could modify something on Reddit,
+// i.e. anything but GET and HEAD requests. Such requests fail with ErrReadOnly before reaching the network.
+// This is useful for dry runs and audit tools.
+func WithReadOnly() Opt {
+	return func(c *Client) error {
+		c.readOnly = true
+		return nil
+	}
+}
+
+// WithTransport sets the transport used to send the client's requests, e.g. to add
+// tracing or metrics middleware. It replaces the transport of the *http.Client passed to NewClient.
+//
+// When the client has credentials, requests go through the OAuth layer first, which sets the
+// Authorization and User-Agent headers, then through rt, so rt sees the requests as they are sent.
+// rt is also used to fetch access tokens.
+func WithTransport(rt http.RoundTripper) Opt {
+	return func(c *Client) error {
+		if rt == nil {
+			return errors.New("transport: cannot be nil")
+		}
+		c.client.Transport = rt
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares around the transport used to send the client's requests.
+// They are applied in order, so the first one is the first to see a request and the last to see its response.
+//
+// Like the transport set with WithTransport, middlewares sit underneath the OAuth layer, so they
+// see requests with the Authorization and User-Agent headers already set, as well as token requests.
+func WithMiddleware(mw ...Middleware) Opt {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, mw...)
+		return nil
+	}
+}
+
+// WithLogger sets a logger used to log every request sent by the client and its response,
+// by adding LoggingMiddleware(logger) to the client's middlewares. See LoggingMiddleware
+// for what is logged.
+func WithLogger(logger *slog.Logger) Opt {
+	return func(c *Client) error {
+		c.logger = logger
+		c.middlewares = append(c.middlewares, LoggingMiddleware(logger))
+		return nil
+	}
+}
@@ -1,10 +1,18 @@
 package reddit
 
 import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 func TestFromEnv(t *testing.T) {
@@ -32,11 +40,136 @@ func TestFromEnv(t *testing.T) {
 	require.Equal(t, expect, actual)
 }
 
+func TestWithCredentialsFromEnv(t *testing.T) {
+	t.Setenv("REDDIT_CLIENT_ID", "id1")
+	t.Setenv("REDDIT_CLIENT_SECRET", "secret1")
+	t.Setenv("REDDIT_USERNAME", "username1")
+	t.Setenv("REDDIT_PASSWORD", "password1")
+
+	c, err := NewClient(nil, nil, WithCredentialsFromEnv())
+	require.NoError(t, err)
+	require.Equal(t, &Credentials{"id1", "secret1", "username1", "password1"}, &Credentials{c.ID, c.Secret, c.Username, c.Password})
+	require.IsType(t, &apiAuthTransport{}, c.client.Transport)
+	require.IsType(t, &oauth2.Transport{}, c.client.Transport.(*apiAuthTransport).auth)
+
+	// credentials passed to NewClient take precedence
+	c, err = NewClient(nil, &Credentials{"id2", "secret2", "username2", "password2"}, WithCredentialsFromEnv())
+	require.NoError(t, err)
+	require.Equal(t, "id2", c.ID)
+}
+
+func TestWithCredentialsFromEnv_Missing(t *testing.T) {
+	t.Setenv("REDDIT_CLIENT_ID", "")
+	t.Setenv("REDDIT_CLIENT_SECRET", "secret1")
+	_, err := NewClient(nil, nil, WithCredentialsFromEnv())
+	require.EqualError(t, err, "REDDIT_CLIENT_ID: environment variable is not set")
+
+	t.Setenv("REDDIT_CLIENT_ID", "id1")
+	t.Setenv("REDDIT_CLIENT_SECRET", "")
+	_, err = NewClient(nil, nil, WithCredentialsFromEnv())
+	require.EqualError(t, err, "REDDIT_CLIENT_SECRET: environment variable is not set")
+
+	t.Setenv("REDDIT_CLIENT_SECRET", "secret1")
+	t.Setenv("REDDIT_USERNAME", "username1")
+	t.Setenv("REDDIT_PASSWORD", "")
+	_, err = NewClient(nil, nil, WithCredentialsFromEnv())
+	require.EqualError(t, err, "REDDIT_PASSWORD: environment variable is not set, it is required when REDDIT_USERNAME is set")
+
+	t.Setenv("REDDIT_USERNAME", "")
+	t.Setenv("REDDIT_PASSWORD", "password1")
+	_, err = NewClient(nil, nil, WithCredentialsFromEnv())
+	require.EqualError(t, err, "REDDIT_USERNAME: environment variable is not set, it is required when REDDIT_PASSWORD is set")
+}
+
+func TestWithCredentialsFromEnv_ApplicationOnly(t *testing.T) {
+	t.Setenv("REDDIT_CLIENT_ID", "id1")
+	t.Setenv("REDDIT_CLIENT_SECRET", "secret1")
+	t.Setenv("REDDIT_USERNAME", "")
+	t.Setenv("REDDIT_PASSWORD", "")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "id1", id)
+		require.Equal(t, "secret1", secret)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		require.Empty(t, r.Form.Get("username"))
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{"access_token": "token1", "token_type": "bearer", "expires_in": 3600}`)
+	})
+
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{}`)
+	})
+
+	c, err := NewClient(nil, nil,
+		WithCredentialsFromEnv(),
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+	)
+	require.NoError(t, err)
+	require.Empty(t, c.Username)
+	require.Empty(t, c.Password)
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/me", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+}
+
 func TestWithBaseURL(t *testing.T) {
 	baseURL := "http://localhost:8080"
 	c, err := NewClient(nil, nil, WithBaseURL(baseURL))
 	require.NoError(t, err)
 	require.Equal(t, baseURL, c.BaseURL.String())
+
+	c, err = NewClient(nil, nil, WithBaseURL("http://localhost:8080/prefix"))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/prefix/", c.BaseURL.String())
+
+	req, err := c.NewRequest(http.MethodGet, "r/golang/hot", nil)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/prefix/r/golang/hot", req.URL.String())
+
+	for _, u := range []string{"", "/relative/path", "localhost:8080"} {
+		_, err = NewClient(nil, nil, WithBaseURL(u))
+		require.EqualError(t, err, fmt.Sprintf("baseURL: %q must have a host", u))
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	userAgent := "linux:com.example.test:v1.0.0 (by /u/test)"
+	c, err := NewClient(nil, nil, WithUserAgent(userAgent))
+	require.NoError(t, err)
+	require.Equal(t, userAgent, c.UserAgent())
+
+	_, err = NewClient(nil, nil, WithUserAgent(" "))
+	require.EqualError(t, err, "userAgent: cannot be empty")
+
+	_, err = NewClient(nil, nil, WithUserAgent("mybot"))
+	require.EqualError(t, err, `userAgent: "mybot" must contain a slash, e.g. platform:app_id:version (by /u/username)`)
+
+	_, err = NewClient(nil, nil, WithUserAgent("Go-http-client/1.1"))
+	require.EqualError(t, err, `userAgent: "Go-http-client/1.1" is a generic user agent, use the format platform:app_id:version (by /u/username)`)
+
+	handler := new(capturingHandler)
+	browser := "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36"
+	_, err = NewClient(nil, nil, WithUserAgent(browser), WithLogger(slog.New(handler)))
+	require.NoError(t, err)
+	require.Len(t, handler.records, 1)
+	require.Equal(t, slog.LevelWarn, handler.records[0].Level)
+	require.Equal(t, browser, recordAttrs(handler.records[0])["user_agent"].String())
 }
 
 func TestWithTokenURL(t *testing.T) {
@@ -45,3 +178,101 @@ func TestWithTokenURL(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tokenURL, c.TokenURL.String())
 }
+
+func TestWithTimeout(t *testing.T) {
+	timeout := time.Second * 5
+	c, err := NewClient(nil, nil, WithTimeout(timeout))
+	require.NoError(t, err)
+	require.Equal(t, timeout, c.timeout)
+}
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWithDefaultTimeout(t *testing.T) {
+	c, err := NewClient(nil, nil)
+	require.NoError(t, err)
+	require.Zero(t, c.defaultTimeout)
+
+	timeout := time.Second * 5
+	c, err = NewClient(nil, nil, WithDefaultTimeout(timeout))
+	require.NoError(t, err)
+	require.Equal(t, timeout, c.defaultTimeout)
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	_, err := NewClient(nil, nil, WithRateLimiter(0, 1))
+	require.EqualError(t, err, "rps: must be greater than 0")
+
+	_, err = NewClient(nil, nil, WithRateLimiter(1, 0))
+	require.EqualError(t, err, "burst: must be greater than 0")
+
+	c, err := NewClient(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, c.limiter)
+
+	c, err = NewClient(nil, nil, WithRateLimiter(2, 3))
+	require.NoError(t, err)
+	require.Equal(t, rate.Limit(2), c.limiter.Limit())
+	require.Equal(t, 3, c.limiter.Burst())
+}
+
+func TestWithReadOnly(t *testing.T) {
+	c, err := NewClient(nil, nil)
+	require.NoError(t, err)
+	require.False(t, c.readOnly)
+
+	c, err = NewClient(nil, nil, WithReadOnly())
+	require.NoError(t, err)
+	require.True(t, c.readOnly)
+}
+
+func TestWithTransport(t *testing.T) {
+	_, err := NewClient(nil, nil, WithTransport(nil))
+	require.EqualError(t, err, "transport: cannot be nil")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{"access_token": "token1", "token_type": "bearer", "expires_in": 3600, "scope": "*"}`)
+	})
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {})
+
+	transport := new(recordingTransport)
+	client, err := NewClient(nil,
+		&Credentials{"id1", "secret1", "user1", "password1"},
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithTransport(transport),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	require.Len(t, transport.requests, 2)
+	require.Equal(t, "/api/v1/access_token", transport.requests[0].URL.Path)
+	require.Equal(t, "/api/v1/test", transport.requests[1].URL.Path)
+	require.Equal(t, "Bearer token1", transport.requests[1].Header.Get("Authorization"))
+	require.Equal(t, client.UserAgent(), transport.requests[1].Header.Get(headerUserAgent))
+}
+
+func TestWithLogger(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewClient(nil, nil, WithLogger(logger))
+	require.NoError(t, err)
+	require.Equal(t, logger, c.logger)
+	require.Len(t, c.middlewares, 1)
+}
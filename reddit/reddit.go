@@ -4,16 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -29,6 +35,10 @@ const (
 	headerContentType = "Content-Type"
 	headerAccept      = "Accept"
 	headerUserAgent   = "User-Agent"
+
+	headerRateLimitRemaining = "X-Ratelimit-Remaining"
+	headerRateLimitUsed      = "X-Ratelimit-Used"
+	headerRateLimitReset     = "X-Ratelimit-Reset"
 )
 
 // cloneRequest returns a clone of the provided *http.Request.
@@ -73,6 +83,14 @@ func (t *userAgentTransport) base() http.RoundTripper {
 // RequestCompletionCallback defines the type of the request callback function.
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
+type noRedirectsKey struct{}
+
+// withoutRedirects returns a copy of ctx that tells the client's CheckRedirect not to follow
+// redirects, so that requests made with it return the redirect response itself.
+func withoutRedirects(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRedirectsKey{}, true)
+}
+
 // Credentials used to authenticate to make requests to the Reddit API.
 type Credentials struct {
 	ID       string
@@ -100,6 +118,7 @@ type Client struct {
 	redditID string
 
 	Account    *AccountService
+	Award      *AwardService
 	Collection *CollectionService
 	Comment    *CommentService
 	Emoji      *EmojiService
@@ -108,18 +127,39 @@ type Client struct {
 	Listings   *ListingsService
 	Message    *MessageService
 	Moderation *ModerationService
+	Modmail    *ModmailService
 	Multi      *MultiService
 	Post       *PostService
+	Search     *SearchService
 	Stream     *StreamService
 	Subreddit  *SubredditService
 	User       *UserService
+	Wiki       *WikiService
 
 	oauth2Transport *oauth2.Transport
 
+	// Credentials set by an option, used if none are passed to NewClient.
+	credentials *Credentials
+
 	onRequestCompleted RequestCompletionCallback
+	logger             *slog.Logger
+	middlewares        []Middleware
+
+	// If positive, each request is cancelled if it takes longer than this.
+	timeout time.Duration
+	// If positive, each request whose context has no deadline is cancelled if it takes longer than this.
+	defaultTimeout time.Duration
+
+	// If set, each request waits for a token from it before being sent.
+	limiter *rate.Limiter
+
+	// If true, requests other than GET and HEAD fail with ErrReadOnly.
+	readOnly bool
 }
 
 // OnRequestCompleted sets the client's request completion callback.
+//
+// Deprecated: use WithMiddleware, which also sees requests that fail without a response.
 func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 	c.onRequestCompleted = rc
 }
@@ -129,29 +169,28 @@ func newClient(httpClient *http.Client) *Client {
 		httpClient = &http.Client{}
 	}
 
+	baseURL, _ := url.Parse(defaultBaseURL)
+	tokenURL, _ := url.Parse(defaultTokenURL)
+
+	client := &Client{client: httpClient, BaseURL: baseURL, TokenURL: tokenURL}
+
 	// todo...
 	// Some endpoints (notably the ones to get random subreddits/posts) redirect to a
 	// reddit.com url, which returns a 403 Forbidden for some reason, unless the url's
-	// host is changed to oauth.reddit.com
+	// host is changed to the one of the base URL, i.e. oauth.reddit.com by default
 	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-		redirectURL := req.URL.String()
-		redirectURL = strings.Replace(redirectURL, "https://www.reddit.com", defaultBaseURL, 1)
-
-		reqURL, err := url.Parse(redirectURL)
-		if err != nil {
-			return err
+		if noRedirects, _ := req.Context().Value(noRedirectsKey{}).(bool); noRedirects {
+			return http.ErrUseLastResponse
+		}
+		if req.URL.Scheme == "https" && req.URL.Host == "www.reddit.com" {
+			req.URL.Scheme = client.BaseURL.Scheme
+			req.URL.Host = client.BaseURL.Host
 		}
-		req.URL = reqURL
-
 		return nil
 	}
 
-	baseURL, _ := url.Parse(defaultBaseURL)
-	tokenURL, _ := url.Parse(defaultTokenURL)
-
-	client := &Client{client: httpClient, BaseURL: baseURL, TokenURL: tokenURL}
-
 	client.Account = &AccountService{client: client}
+	client.Award = &AwardService{client: client}
 	client.Collection = &CollectionService{client: client}
 	client.Emoji = &EmojiService{client: client}
 	client.Flair = &FlairService{client: client}
@@ -159,10 +198,13 @@ func newClient(httpClient *http.Client) *Client {
 	client.Listings = &ListingsService{client: client}
 	client.Message = &MessageService{client: client}
 	client.Moderation = &ModerationService{client: client}
+	client.Modmail = &ModmailService{client: client}
 	client.Multi = &MultiService{client: client}
+	client.Search = &SearchService{client: client}
 	client.Stream = &StreamService{client: client}
 	client.Subreddit = &SubredditService{client: client}
 	client.User = &UserService{client: client}
+	client.Wiki = &WikiService{client: client}
 
 	postAndCommentService := &postAndCommentService{client: client}
 	client.Comment = &CommentService{client: client, postAndCommentService: postAndCommentService}
@@ -173,6 +215,15 @@ func newClient(httpClient *http.Client) *Client {
 
 // NewClient returns a new Reddit API client. If a nil httpClient is provided,
 // a new http.Client will be used.
+//
+// Requests are sent to https://oauth.reddit.com by default. To send them elsewhere,
+// e.g. to a mock server in tests, use the WithBaseURL and WithTokenURL options:
+//
+//	server := httptest.NewServer(mux)
+//	client, err := reddit.NewClient(nil, credentials,
+//		reddit.WithBaseURL(server.URL),
+//		reddit.WithTokenURL(server.URL+"/api/v1/access_token"),
+//	)
 func NewClient(httpClient *http.Client, creds *Credentials, opts ...Opt) (*Client, error) {
 	client := newClient(httpClient)
 
@@ -182,6 +233,20 @@ func NewClient(httpClient *http.Client, creds *Credentials, opts ...Opt) (*Clien
 		}
 	}
 
+	if client.logger != nil && isBrowserUserAgent(client.userAgent) {
+		client.logger.Warn("reddit: the user agent looks like a browser's, Reddit may throttle or block it",
+			slog.String("user_agent", client.userAgent),
+		)
+	}
+
+	if len(client.middlewares) > 0 {
+		client.client.Transport = chainMiddleware(client.client.Transport, client.middlewares)
+	}
+
+	if creds == nil {
+		creds = client.credentials
+	}
+
 	if creds != nil {
 		client.ID = creds.ID
 		client.Secret = creds.Secret
@@ -196,6 +261,7 @@ func NewClient(httpClient *http.Client, creds *Credentials, opts ...Opt) (*Clien
 }
 
 // UserAgent returns the client's user agent.
+// Unless set with WithUserAgent, it is golang:github.com/vartanbeno/go-reddit:v{version} (by /u/{username}).
 func (c *Client) UserAgent() string {
 	if c.userAgent == "" {
 		c.userAgent = fmt.Sprintf("golang:%s:v%s (by /u/%s)", libraryName, libraryVersion, c.Username)
@@ -232,6 +298,71 @@ func (c *Client) NewRequest(method string, path string, body interface{}) (*http
 	return req, nil
 }
 
+// newJSONForm returns form values with api_type=json set, which the endpoints
+// under api/ expect in order to respond with JSON, including for errors.
+func newJSONForm() url.Values {
+	return url.Values{"api_type": {"json"}}
+}
+
+// encodeForm encodes v, a struct using url tags, as form values.
+// Fields tagged with omitempty are left out when empty. A nil v returns empty values.
+func encodeForm(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	if v == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return url.Values{}, nil
+	}
+	return query.Values(v)
+}
+
+// encodeJSONForm is like encodeForm, but also sets api_type=json, like newJSONForm.
+func encodeJSONForm(v interface{}) (url.Values, error) {
+	form, err := encodeForm(v)
+	if err != nil {
+		return nil, err
+	}
+	form.Set("api_type", "json")
+	return form, nil
+}
+
+// newRequestWithMultipart creates an API request with a multipart body made of the fields,
+// followed by the file under the fileField name.
+func (c *Client) newRequestWithMultipart(method, path string, fields url.Values, fileField, filename string, file io.Reader) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	for k := range fields {
+		if err := writer.WriteField(k, fields.Get(k)); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add(headerContentType, writer.FormDataContentType())
+	req.Header.Add(headerAccept, mediaTypeJSON)
+
+	return req, nil
+}
+
 // NewRequestWithForm creates an API request with form data.
 // The path is the relative URL which will be resolves to the BaseURL of the Client.
 // It should always be specified without a preceding slash.
@@ -255,21 +386,90 @@ func (c *Client) NewRequestWithForm(method string, path string, form url.Values)
 // Response is a PlayNetwork response. This wraps the standard http.Response returned from PlayNetwork.
 type Response struct {
 	*http.Response
+
+	// Rate limit information parsed from the response's headers.
+	RateLimitInfo RateLimitInfo
+}
+
+// RateLimitInfo is the state of the client's rate limit, as reported by Reddit.
+type RateLimitInfo struct {
+	// The number of requests remaining in the current period.
+	Remaining float64
+	// The number of requests made in the current period.
+	Used int
+	// When the current period ends. Zero if Reddit did not report it.
+	ResetAt time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
+	response.populateRateLimitInfo()
 	return &response
 }
 
+// populateRateLimitInfo parses the rate limit headers of the response.
+// Reddit sends the number of seconds until the reset, which is added to the
+// response's Date header, or to the current time if the response has none.
+func (r *Response) populateRateLimitInfo() {
+	if v := r.Header.Get(headerRateLimitRemaining); v != "" {
+		r.RateLimitInfo.Remaining, _ = strconv.ParseFloat(v, 64)
+	}
+
+	if v := r.Header.Get(headerRateLimitUsed); v != "" {
+		r.RateLimitInfo.Used, _ = strconv.Atoi(v)
+	}
+
+	if v := r.Header.Get(headerRateLimitReset); v != "" {
+		seconds, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return
+		}
+
+		now, err := http.ParseTime(r.Header.Get("Date"))
+		if err != nil {
+			now = time.Now()
+		}
+		r.RateLimitInfo.ResetAt = now.Add(time.Duration(seconds * float64(time.Second))).UTC()
+	}
+}
+
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if c.readOnly && req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil, fmt.Errorf("%w: cannot send %s %s", ErrReadOnly, req.Method, req.URL.Path)
+	}
+
+	// checked before applying c.timeout, so that the default timeout still applies
+	// to requests without a deadline of their own when both are set
+	_, hasDeadline := ctx.Deadline()
+	if c.timeout > 0 {
+		// if ctx already has an earlier deadline, it is kept
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	if !hasDeadline && c.defaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+		defer cancel()
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.do(ctx, req, v)
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	resp, err := DoRequestWithClient(ctx, c.client, req)
 	if err != nil {
-		return nil, err
+		return nil, contextError(ctx, err)
 	}
 	defer resp.Body.Close()
 
@@ -281,19 +481,19 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	err = CheckResponse(resp)
 	if err != nil {
-		return response, err
+		return response, contextError(ctx, err)
 	}
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, response.Body)
 			if err != nil {
-				return nil, err
+				return nil, contextError(ctx, err)
 			}
 		} else {
 			err = json.NewDecoder(response.Body).Decode(v)
 			if err != nil {
-				return nil, err
+				return nil, contextError(ctx, err)
 			}
 		}
 	}
@@ -301,6 +501,16 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, nil
 }
 
+// contextError returns the context's error if it was cancelled or its deadline was exceeded,
+// since it is more useful than the error it caused, e.g. a failed read of the response body.
+// Otherwise, it returns err.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
 // id returns the client's Reddit ID.
 func (c *Client) id(ctx context.Context) (string, *Response, error) {
 	if c.redditID != "" {
@@ -316,6 +526,69 @@ func (c *Client) id(ctx context.Context) (string, *Response, error) {
 	return c.redditID, resp, nil
 }
 
+// GetThing gets a post (t3), comment (t1), subreddit (t5) or user (t2) from its full ID.
+// The returned value is a *Post, *Comment, *Subreddit or *User respectively, use a type switch to get it.
+// If nothing has the full ID, the error matches ErrNotFound.
+func (c *Client) GetThing(ctx context.Context, fullID string) (interface{}, *Response, error) {
+	if err := validateFullID(fullID, kindComment, kindAccount, kindPost, kindSubreddit); err != nil {
+		return nil, nil, err
+	}
+
+	if strings.HasPrefix(fullID, kindAccount+"_") {
+		user, resp, err := c.getUserByFullID(ctx, fullID)
+		if err != nil {
+			return nil, resp, err
+		}
+		return user, resp, nil
+	}
+
+	path, err := addOptions("api/info", struct {
+		ID string `url:"id"`
+	}{fullID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := c.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	things := root.Data.Things
+	switch {
+	case len(things.Posts) > 0:
+		return things.Posts[0], resp, nil
+	case len(things.Comments) > 0:
+		return things.Comments[0], resp, nil
+	case len(things.Subreddits) > 0:
+		return things.Subreddits[0], resp, nil
+	}
+
+	return nil, resp, fmt.Errorf("%w: %q", ErrNotFound, fullID)
+}
+
+// getUserByFullID gets a user from its full ID.
+// api/info does not return users, so the username is looked up first.
+func (c *Client) getUserByFullID(ctx context.Context, fullID string) (*User, *Response, error) {
+	summaries, resp, err := c.User.GetMultipleByID(ctx, fullID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	summary, ok := summaries[fullID]
+	if !ok {
+		return nil, resp, fmt.Errorf("%w: %q", ErrNotFound, fullID)
+	}
+
+	return c.User.Get(ctx, summary.Name)
+}
+
 // DoRequest submits an HTTP request.
 func DoRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
 	return DoRequestWithClient(ctx, http.DefaultClient, req)
@@ -327,6 +600,53 @@ func DoRequestWithClient(ctx context.Context, client *http.Client, req *http.Req
 	return client.Do(req)
 }
 
+// leaseURL returns the URL of an upload lease's action, e.g. for emojis or media.
+// Reddit sends it without a scheme, e.g. //reddit-uploaded-media.s3-accelerate.amazonaws.com,
+// so the scheme of the client's BaseURL is used, i.e. https by default.
+func (c *Client) leaseURL(action string) (string, error) {
+	u, err := c.BaseURL.Parse(action)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// uploadToLease uploads the file to the URL of an upload lease obtained from Reddit, e.g. for emojis or media.
+// The lease's fields are sent along with the file.
+func (c *Client) uploadToLease(ctx context.Context, uploadURL string, fields map[string]string, filename string, file io.Reader) (*Response, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	// AWS ignores all fields in the request that come after the file field, so we need to set these before
+	// https://stackoverflow.com/questions/15234496/upload-directly-to-amazon-s3-using-ajax-returning-error-bucket-post-must-contai/15235866#15235866
+	for k, v := range fields {
+		writer.WriteField(k, v)
+	}
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, uploadURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(headerContentType, writer.FormDataContentType())
+
+	return c.Do(ctx, req, nil)
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
 // Reddit also sometimes sends errors with 200 codes; we check for those too.
@@ -334,7 +654,10 @@ func CheckResponse(r *http.Response) error {
 	jsonErrorResponse := &JSONErrorResponse{Response: r}
 
 	data, err := ioutil.ReadAll(r.Body)
-	if err == nil && len(data) > 0 {
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 {
 		json.Unmarshal(data, jsonErrorResponse)
 		if len(jsonErrorResponse.JSON.Errors) > 0 {
 			return jsonErrorResponse
@@ -375,36 +698,189 @@ type ListOptions struct {
 	// as the anchor point of the list. Only items
 	// appearing before it will be returned.
 	Before string `url:"before,omitempty"`
+
+	// Include the full subreddit of each post in the listing, in its Subreddit field.
+	IncludeSubredditDetail bool `url:"sr_detail,omitempty"`
+}
+
+// Validate checks that the limit is within the range accepted by Reddit.
+// A limit of 0 is valid and means Reddit's default is used.
+// It is called by every method that takes list options, before sending any request.
+func (o *ListOptions) Validate() error {
+	return validateLimit(o.Limit, 100)
+}
+
+func validateLimit(limit, max int) error {
+	if limit < 0 || limit > max {
+		return fmt.Errorf("limit: must be between 0 (default) and %d (inclusive), got %d", max, limit)
+	}
+	return nil
+}
+
+// nextPageOptions returns the options anchored after the given full ID,
+// or nil if there is no such item, i.e. there is no next page.
+func nextPageOptions(after string) *ListOptions {
+	if after == "" {
+		return nil
+	}
+	return &ListOptions{After: after}
+}
+
+// Sort is the order in which the items of a listing are returned.
+// Not every sort is supported by every listing.
+type Sort string
+
+// Possible sorts.
+const (
+	SortHot           Sort = "hot"
+	SortNew           Sort = "new"
+	SortTop           Sort = "top"
+	SortControversial Sort = "controversial"
+	SortRising        Sort = "rising"
+	SortBest          Sort = "best"
+	// Only used when searching.
+	SortRelevance Sort = "relevance"
+	// Only used when searching for posts.
+	SortComments Sort = "comments"
+	// Only used when searching for subreddits.
+	SortActivity Sort = "activity"
+)
+
+// IsValid reports whether s is a known sort.
+func (s Sort) IsValid() bool {
+	switch s {
+	case SortHot, SortNew, SortTop, SortControversial, SortRising, SortBest,
+		SortRelevance, SortComments, SortActivity:
+		return true
+	}
+	return false
+}
+
+// CommentSort is the order in which the comments of a post are returned.
+type CommentSort string
+
+// Possible comment sorts.
+const (
+	// Also known as "best".
+	CommentSortConfidence    CommentSort = "confidence"
+	CommentSortTop           CommentSort = "top"
+	CommentSortNew           CommentSort = "new"
+	CommentSortControversial CommentSort = "controversial"
+	CommentSortOld           CommentSort = "old"
+	CommentSortRandom        CommentSort = "random"
+	CommentSortQA            CommentSort = "qa"
+	CommentSortLive          CommentSort = "live"
+)
+
+// IsValid reports whether s is a known comment sort.
+func (s CommentSort) IsValid() bool {
+	switch s {
+	case CommentSortConfidence, CommentSortTop, CommentSortNew, CommentSortControversial,
+		CommentSortOld, CommentSortRandom, CommentSortQA, CommentSortLive:
+		return true
+	}
+	return false
+}
+
+// TimeFilter is the period of time that the items of a listing are restricted to,
+// e.g. when getting the top posts.
+type TimeFilter string
+
+// Possible time filters.
+const (
+	TimeFilterHour  TimeFilter = "hour"
+	TimeFilterDay   TimeFilter = "day"
+	TimeFilterWeek  TimeFilter = "week"
+	TimeFilterMonth TimeFilter = "month"
+	TimeFilterYear  TimeFilter = "year"
+	TimeFilterAll   TimeFilter = "all"
+)
+
+// IsValid reports whether f is a known time filter.
+func (f TimeFilter) IsValid() bool {
+	switch f {
+	case TimeFilterHour, TimeFilterDay, TimeFilterWeek, TimeFilterMonth, TimeFilterYear, TimeFilterAll:
+		return true
+	}
+	return false
 }
 
 // ListSubredditOptions defines possible options used when searching for subreddits.
 type ListSubredditOptions struct {
 	ListOptions
-	// One of: relevance, activity.
-	Sort string `url:"sort,omitempty"`
+	// One of: SortRelevance, SortActivity.
+	Sort Sort `url:"sort,omitempty"`
 }
 
 // ListPostOptions defines possible options used when getting posts from a subreddit.
 type ListPostOptions struct {
 	ListOptions
-	// One of: hour, day, week, month, year, all.
-	Time string `url:"t,omitempty"`
+	Time TimeFilter `url:"t,omitempty"`
 }
 
 // ListPostSearchOptions defines possible options used when searching for posts within a subreddit.
 type ListPostSearchOptions struct {
 	ListPostOptions
-	// One of: relevance, hot, top, new, comments.
-	Sort string `url:"sort,omitempty"`
+	// One of: SortRelevance, SortHot, SortTop, SortNew, SortComments.
+	Sort Sort `url:"sort,omitempty"`
+
+	// Only return posts created after/before these times. They are sent as Unix timestamps in the
+	// after and before parameters, so they cannot be combined with the After/Before anchors.
+	CreatedAfter  *time.Time `url:"after,omitempty,unix"`
+	CreatedBefore *time.Time `url:"before,omitempty,unix"`
+
+	// Whether to include NSFW posts in the results.
+	// If nil, Reddit's default is used, which depends on the user's preferences.
+	IncludeNSFW *bool `url:"include_over_18,omitempty"`
+}
+
+// Validate checks the limit, and that the time range is valid and doesn't
+// conflict with the After/Before anchors.
+func (o *ListPostSearchOptions) Validate() error {
+	if err := o.ListOptions.Validate(); err != nil {
+		return err
+	}
+
+	if o.CreatedAfter != nil && o.After != "" {
+		return errors.New("CreatedAfter: cannot be used with After")
+	}
+	if o.CreatedBefore != nil && o.Before != "" {
+		return errors.New("CreatedBefore: cannot be used with Before")
+	}
+
+	if o.CreatedBefore != nil {
+		if o.CreatedBefore.After(time.Now()) {
+			return errors.New("CreatedBefore: cannot be in the future")
+		}
+		if o.CreatedAfter != nil && !o.CreatedBefore.After(*o.CreatedAfter) {
+			return errors.New("CreatedBefore: must be after CreatedAfter")
+		}
+	}
+
+	return nil
+}
+
+// SearchEverythingOptions defines possible options used when searching all of Reddit.
+type SearchEverythingOptions struct {
+	ListOptions
+	// One of: SortRelevance, SortHot, SortTop, SortNew, SortComments.
+	Sort Sort       `url:"sort,omitempty"`
+	Time TimeFilter `url:"t,omitempty"`
+	// Comma-separated types of things to search for, e.g. "link,sr,user".
+	// If empty, only posts are returned.
+	Type string `url:"type,omitempty"`
+
+	// Whether to include NSFW results.
+	// If nil, Reddit's default is used, which depends on the user's preferences.
+	IncludeNSFW *bool `url:"include_over_18,omitempty"`
 }
 
 // ListUserOverviewOptions defines possible options used when getting a user's post and/or comments.
 type ListUserOverviewOptions struct {
 	ListOptions
-	// One of: hot, new, top, controversial.
-	Sort string `url:"sort,omitempty"`
-	// One of: hour, day, week, month, year, all.
-	Time string `url:"t,omitempty"`
+	// One of: SortHot, SortNew, SortTop, SortControversial.
+	Sort Sort       `url:"sort,omitempty"`
+	Time TimeFilter `url:"t,omitempty"`
 }
 
 // ListDuplicatePostOptions defines possible options used when getting duplicates of a post, i.e.
@@ -420,6 +896,33 @@ type ListDuplicatePostOptions struct {
 	CrosspostsOnly bool `url:"crossposts_only,omitempty"`
 }
 
+// GetCommentsOptions defines possible options used when getting a post with its comments.
+type GetCommentsOptions struct {
+	Sort CommentSort `url:"sort,omitempty"`
+	// The maximum depth of the comment tree. Must be between 1 and 10 (inclusive).
+	Depth int `url:"depth,omitempty"`
+	// The maximum number of comments to return. Must be between 1 and 500 (inclusive).
+	Limit int `url:"limit,omitempty"`
+	// If provided, only this comment (ID36, e.g. abc123) and its replies are returned.
+	CommentID string `url:"comment,omitempty"`
+	// Whether to show the edited timestamp of comments. Reddit's default is true.
+	ShowEdits *bool `url:"showedits,omitempty"`
+	// Whether to return the comments as a tree. Reddit's default is true.
+	Threaded *bool `url:"threaded,omitempty"`
+}
+
+// Validate checks that the depth and limit are within the ranges accepted by Reddit.
+// A depth or limit of 0 means Reddit's default is used.
+func (o *GetCommentsOptions) Validate() error {
+	if o.Sort != "" && !o.Sort.IsValid() {
+		return fmt.Errorf("sort: unknown comment sort %q", o.Sort)
+	}
+	if o.Depth < 0 || o.Depth > 10 {
+		return fmt.Errorf("depth: must be between 1 and 10 (inclusive), got %d", o.Depth)
+	}
+	return validateLimit(o.Limit, 500)
+}
+
 // ListModActionOptions defines possible options used when getting moderation actions in a subreddit.
 type ListModActionOptions struct {
 	// The max for the limit parameter here is 500.
@@ -440,12 +943,32 @@ type ListModActionOptions struct {
 	Moderator string `url:"mod,omitempty"`
 }
 
+// ModLogOptions defines possible options used when reading the moderation log of a subreddit.
+type ModLogOptions = ListModActionOptions
+
+// Validate checks that the limit is within the range accepted by Reddit.
+// A limit of 0 is valid and means Reddit's default is used.
+func (o *ListModActionOptions) Validate() error {
+	return validateLimit(o.Limit, 500)
+}
+
+// validator is implemented by options that must be checked before being sent, e.g. ListOptions.
+type validator interface {
+	Validate() error
+}
+
 func addOptions(s string, opt interface{}) (string, error) {
 	v := reflect.ValueOf(opt)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
 		return s, nil
 	}
 
+	if opt, ok := opt.(validator); ok {
+		if err := opt.Validate(); err != nil {
+			return s, err
+		}
+	}
+
 	origURL, err := url.Parse(s)
 	if err != nil {
 		return s, err
@@ -489,3 +1012,81 @@ func Bool(v bool) *bool {
 	*p = v
 	return p
 }
+
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 {
+	p := new(int64)
+	*p = v
+	return p
+}
+
+// Float32 is a helper routine that allocates a new float32 value
+// to store v and returns a pointer to it.
+func Float32(v float32) *float32 {
+	p := new(float32)
+	*p = v
+	return p
+}
+
+// Float64 is a helper routine that allocates a new float64 value
+// to store v and returns a pointer to it.
+func Float64(v float64) *float64 {
+	p := new(float64)
+	*p = v
+	return p
+}
+
+// StringValue returns the value of the string pointer passed in,
+// or the empty string if the pointer is nil.
+func StringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// IntValue returns the value of the int pointer passed in,
+// or 0 if the pointer is nil.
+func IntValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// BoolValue returns the value of the bool pointer passed in,
+// or false if the pointer is nil.
+func BoolValue(v *bool) bool {
+	if v == nil {
+		return false
+	}
+	return *v
+}
+
+// Int64Value returns the value of the int64 pointer passed in,
+// or 0 if the pointer is nil.
+func Int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// Float32Value returns the value of the float32 pointer passed in,
+// or 0 if the pointer is nil.
+func Float32Value(v *float32) float32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// Float64Value returns the value of the float64 pointer passed in,
+// or 0 if the pointer is nil.
+func Float64Value(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -7,16 +7,20 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 var ctx = context.Background()
 
-func setup() (*Client, *http.ServeMux, func()) {
+func setup(opts ...Opt) (*Client, *http.ServeMux, func()) {
 	mux := http.NewServeMux()
 	server := httptest.NewServer(mux)
 
@@ -31,11 +35,11 @@ func setup() (*Client, *http.ServeMux, func()) {
 		fmt.Fprint(w, response)
 	})
 
-	client, _ := NewClient(nil,
-		&Credentials{"id1", "secret1", "user1", "password1"},
+	opts = append([]Opt{
 		WithBaseURL(server.URL),
-		WithTokenURL(server.URL+"/api/v1/access_token"),
-	)
+		WithTokenURL(server.URL + "/api/v1/access_token"),
+	}, opts...)
+	client, _ := NewClient(nil, &Credentials{"id1", "secret1", "user1", "password1"}, opts...)
 
 	return client, mux, server.Close
 }
@@ -58,6 +62,7 @@ func readFileContents(path string) (string, error) {
 func testClientServices(t *testing.T, c *Client) {
 	services := []string{
 		"Account",
+		"Award",
 		"Collection",
 		"Comment",
 		"Emoji",
@@ -66,6 +71,7 @@ func testClientServices(t *testing.T, c *Client) {
 		"Listings",
 		"Message",
 		"Moderation",
+		"Modmail",
 		"Multi",
 		"Post",
 		"Stream",
@@ -184,3 +190,608 @@ func TestClient_ErrorResponse(t *testing.T) {
 	require.EqualError(t, err, fmt.Sprintf(`GET %s/api/v1/test: 403 error message`, client.BaseURL))
 	require.Equal(t, http.StatusForbidden, resp.StatusCode)
 }
+
+func TestClient_ErrorResponse_Sentinels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		code, err := strconv.Atoi(r.URL.Query().Get("code"))
+		require.NoError(t, err)
+		// no body, only the status code
+		w.WriteHeader(code)
+	})
+
+	tests := []struct {
+		code int
+		want error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+	for _, tc := range tests {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("api/v1/test?code=%d", tc.code), nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(ctx, req, nil)
+		require.True(t, errors.Is(err, tc.want), "status %d", tc.code)
+		for _, other := range tests {
+			if other.want != tc.want {
+				require.False(t, errors.Is(err, other.want), "status %d", tc.code)
+			}
+		}
+
+		var errorResponse *ErrorResponse
+		require.True(t, errors.As(err, &errorResponse))
+		require.Equal(t, tc.code, errorResponse.Response.StatusCode)
+	}
+}
+
+func TestClient_JSONErrorResponse_Sentinels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"json": {"errors": [["%s", "error message", "field"]]}}`, r.URL.Query().Get("label"))
+	})
+
+	tests := []struct {
+		label string
+		want  error
+	}{
+		{"SUBREDDIT_NOEXIST", ErrSubredditNotFound},
+		{"USER_DOESNT_EXIST", ErrUserNotFound},
+		{"RATELIMIT", ErrRateLimited},
+		{"BAD_CAPTCHA", ErrBadCaptcha},
+		{"INSUFFICIENT_COINS", ErrInsufficientCoins},
+	}
+	for _, tc := range tests {
+		req, err := client.NewRequest(http.MethodGet, "api/v1/test?label="+tc.label, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(ctx, req, nil)
+		require.True(t, errors.Is(err, tc.want), tc.label)
+		require.False(t, errors.Is(err, ErrNotFound), tc.label)
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		require.Equal(t, &APIError{Label: tc.label, Reason: "error message", Field: "field"}, apiErr)
+	}
+}
+
+func TestClient_ErrorResponse_Reason(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"explanation": "You don't have enough coins.", "message": "Bad Request", "reason": "INSUFFICIENT_COINS_WITH_AMOUNT"}`)
+	})
+
+	_, _, err := client.Post.Award(ctx, "t3_test", "gid_2", nil)
+	require.True(t, errors.Is(err, ErrInsufficientCoins))
+	require.False(t, errors.Is(err, ErrForbidden))
+
+	var errorResponse *ErrorResponse
+	require.True(t, errors.As(err, &errorResponse))
+	require.Equal(t, "Bad Request", errorResponse.Message)
+	require.Equal(t, "INSUFFICIENT_COINS_WITH_AMOUNT", errorResponse.Reason)
+}
+
+func TestClient_ReadOnly(t *testing.T) {
+	client, mux, teardown := setup(WithReadOnly())
+	defer teardown()
+
+	var requested bool
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	})
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind": "t5", "data": {"display_name": "test"}}`)
+	})
+
+	_, err := client.Post.Upvote(ctx, "t3_test")
+	require.True(t, errors.Is(err, ErrReadOnly))
+	require.EqualError(t, err, "client is read-only: cannot send POST /api/vote")
+	require.False(t, requested)
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+}
+
+func TestClient_UploadToLease(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"kind": "t5", "data": {"display_name": "test"}}`)
+	})
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		// the access token is only sent to the API
+		require.Empty(t, r.Header.Get("Authorization"))
+		require.Equal(t, client.UserAgent(), r.Header.Get(headerUserAgent))
+
+		err := r.ParseMultipartForm(1024)
+		require.NoError(t, err)
+		require.Equal(t, "key1", r.FormValue("key"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer storage.Close()
+
+	// API requests are still authenticated
+	_, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+
+	uploadURL, err := client.leaseURL("//" + strings.TrimPrefix(storage.URL, "http://"))
+	require.NoError(t, err)
+	require.Equal(t, storage.URL, uploadURL)
+
+	resp, err := client.uploadToLease(ctx, uploadURL, map[string]string{"key": "key1"}, "image.png", strings.NewReader("image"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	client, _, teardown = setup(WithReadOnly())
+	defer teardown()
+
+	_, err = client.uploadToLease(ctx, uploadURL, nil, "image.png", strings.NewReader("image"))
+	require.True(t, errors.Is(err, ErrReadOnly))
+}
+
+func TestClient_AuthenticatesCurrentBaseURL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+	})
+
+	// the base URL is changed after the client is created
+	other := httptest.NewServer(mux)
+	defer other.Close()
+
+	baseURL, err := url.Parse(other.URL)
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	require.Equal(t, baseURL.Host, req.URL.Host)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+}
+
+func TestClient_LeaseURL(t *testing.T) {
+	client, _, teardown := setup(WithBaseURL("https://oauth.reddit.com"))
+	defer teardown()
+
+	uploadURL, err := client.leaseURL("//reddit-uploaded-emoji.s3-accelerate.amazonaws.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://reddit-uploaded-emoji.s3-accelerate.amazonaws.com", uploadURL)
+}
+
+func TestClient_DefaultTimeout(t *testing.T) {
+	client, mux, teardown := setup(WithDefaultTimeout(time.Millisecond * 50))
+	defer teardown()
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Millisecond * 200):
+			fmt.Fprint(w, `{"kind": "t5", "data": {"display_name": "test"}}`)
+		}
+	})
+
+	start := time.Now()
+	_, _, err := client.Subreddit.Get(ctx, "test")
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.True(t, time.Since(start) < time.Millisecond*200)
+
+	// a deadline set by the caller takes precedence, even if it is later
+	deadlineCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	subreddit, _, err := client.Subreddit.Get(deadlineCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+}
+
+func TestClient_TimeoutAndDefaultTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	})
+
+	do := func(ctx context.Context, opts ...Opt) time.Duration {
+		client, err := NewClient(nil, nil, append([]Opt{WithBaseURL(server.URL)}, opts...)...)
+		require.NoError(t, err)
+
+		req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = client.Do(ctx, req, nil)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+		return time.Since(start)
+	}
+
+	// without a deadline, the shorter of the two applies
+	elapsed := do(ctx, WithTimeout(time.Millisecond*500), WithDefaultTimeout(time.Millisecond*50))
+	require.True(t, elapsed < time.Millisecond*400, elapsed)
+
+	elapsed = do(ctx, WithTimeout(time.Millisecond*50), WithDefaultTimeout(time.Millisecond*500))
+	require.True(t, elapsed < time.Millisecond*400, elapsed)
+
+	// with a deadline, the default timeout is ignored, but the timeout still applies
+	deadlineCtx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	elapsed = do(deadlineCtx, WithTimeout(time.Millisecond*50), WithDefaultTimeout(time.Millisecond*500))
+	require.True(t, elapsed < time.Millisecond*400, elapsed)
+
+	elapsed = do(deadlineCtx, WithTimeout(time.Millisecond*500), WithDefaultTimeout(time.Millisecond*50))
+	require.True(t, elapsed >= time.Millisecond*500, elapsed)
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	client, mux, teardown := setup(WithRateLimiter(10, 1))
+	defer teardown()
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	// the first request uses the burst, the second one waits for a new token
+	start := time.Now()
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.True(t, time.Since(start) >= time.Millisecond*90)
+	require.Equal(t, 2, counter)
+
+	// the wait is interrupted when the context is done, and the request is not sent
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = client.Do(cancelledCtx, req, nil)
+	require.Error(t, err)
+	require.Equal(t, 2, counter)
+}
+
+func TestClient_ContextCancelled(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	// the handler hangs before sending a response
+	mux.HandleFunc("/api/v1/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	})
+	// the handler sends the headers and part of the body, then hangs
+	mux.HandleFunc("/api/v1/slow-body", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": `)
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	})
+
+	for _, path := range []string{"api/v1/slow", "api/v1/slow-body"} {
+		req, err := client.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		time.AfterFunc(time.Millisecond*50, cancel)
+
+		start := time.Now()
+		_, err = client.Do(cancelCtx, req, new(map[string]interface{}))
+		require.Equal(t, context.Canceled, err, path)
+		require.True(t, time.Since(start) < time.Second, path)
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	userAgent := "linux:com.example.test:v1.0.0 (by /u/test)"
+	client, mux, teardown := setup(WithUserAgent(userAgent))
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, userAgent, r.Header.Get(headerUserAgent))
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+}
+
+func TestClient_RedirectToBaseURL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/random", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://www.reddit.com/r/test/about", http.StatusFound)
+	})
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ok": true}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "r/random", nil)
+	require.NoError(t, err)
+
+	root := new(struct {
+		OK bool `json:"ok"`
+	})
+	resp, err := client.Do(ctx, req, root)
+	require.NoError(t, err)
+	require.True(t, root.OK)
+	require.Equal(t, client.BaseURL.Host, resp.Request.URL.Host)
+}
+
+func TestClient_RateLimitInfo(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/limited", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", "Sat, 05 Sep 2020 14:29:00 GMT")
+		w.Header().Set("X-Ratelimit-Remaining", "598.0")
+		w.Header().Set("X-Ratelimit-Used", "2")
+		w.Header().Set("X-Ratelimit-Reset", "60.5")
+	})
+	mux.HandleFunc("/api/v1/unlimited", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/limited", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, RateLimitInfo{
+		Remaining: 598,
+		Used:      2,
+		ResetAt:   time.Date(2020, 9, 5, 14, 30, 0, 500000000, time.UTC),
+	}, resp.RateLimitInfo)
+
+	req, err = client.NewRequest(http.MethodGet, "api/v1/unlimited", nil)
+	require.NoError(t, err)
+
+	resp, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, RateLimitInfo{}, resp.RateLimitInfo)
+	require.True(t, resp.RateLimitInfo.ResetAt.IsZero())
+}
+
+func TestClient_GetThing(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	things := map[string]string{
+		"t3_test": `{"kind": "t3", "data": {"id": "test", "name": "t3_test", "title": "Test post"}}`,
+		"t1_test": `{"kind": "t1", "data": {"id": "test", "name": "t1_test", "body": "Test comment", "replies": ""}}`,
+		"t5_test": `{"kind": "t5", "data": {"id": "test", "name": "t5_test", "display_name": "test"}}`,
+	}
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		fmt.Fprintf(w, `{"kind": "Listing", "data": {"children": [%s]}}`, things[r.Form.Get("id")])
+	})
+
+	blob, err := readFileContents("../testdata/user/get.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/user_data_by_account_ids", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t2_test", r.Form.Get("ids"))
+
+		fmt.Fprint(w, `{"t2_test": {"name": "Test_User"}}`)
+	})
+
+	mux.HandleFunc("/user/Test_User/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	thing, _, err := client.GetThing(ctx, "t3_test")
+	require.NoError(t, err)
+	require.Equal(t, &Post{ID: "test", FullID: "t3_test", Title: "Test post"}, thing)
+
+	thing, _, err = client.GetThing(ctx, "t1_test")
+	require.NoError(t, err)
+	require.Equal(t, &Comment{ID: "test", FullID: "t1_test", Body: "Test comment"}, thing)
+
+	thing, _, err = client.GetThing(ctx, "t5_test")
+	require.NoError(t, err)
+	require.Equal(t, &Subreddit{ID: "test", FullID: "t5_test", Name: "test"}, thing)
+
+	thing, _, err = client.GetThing(ctx, "t2_test")
+	require.NoError(t, err)
+	require.Equal(t, expectedUser, thing)
+
+	thing, _, err = client.GetThing(ctx, "t3_notfound")
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Nil(t, thing)
+
+	_, _, err = client.GetThing(ctx, "t4_test")
+	require.True(t, errors.Is(err, ErrInvalidFullID))
+}
+
+func TestClient_Timeout(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	err := WithTimeout(time.Millisecond * 50)(client)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(ctx, req, nil)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.True(t, time.Since(start) < time.Second)
+}
+
+func TestNextPageOptions(t *testing.T) {
+	require.Nil(t, (&Posts{Before: "t3_before"}).NextPageOptions())
+	require.Equal(t, &ListOptions{After: "t3_after"}, (&Posts{After: "t3_after"}).NextPageOptions())
+
+	require.Nil(t, (&Messages{}).NextPageOptions())
+	require.Equal(t, &ListOptions{After: "t4_after"}, (&Messages{After: "t4_after"}).NextPageOptions())
+
+	require.Nil(t, (&Relationships{}).NextPageOptions())
+	require.Equal(t, &ListOptions{After: "r9_after"}, (&Relationships{After: "r9_after"}).NextPageOptions())
+}
+
+func TestSort_IsValid(t *testing.T) {
+	require.True(t, SortHot.IsValid())
+	require.True(t, SortBest.IsValid())
+	require.True(t, SortRelevance.IsValid())
+	require.False(t, Sort("").IsValid())
+	require.False(t, Sort("newest").IsValid())
+}
+
+func TestTimeFilter_IsValid(t *testing.T) {
+	require.True(t, TimeFilterHour.IsValid())
+	require.True(t, TimeFilterAll.IsValid())
+	require.False(t, TimeFilter("").IsValid())
+	require.False(t, TimeFilter("decade").IsValid())
+}
+
+func TestListOptions_Validate(t *testing.T) {
+	for _, limit := range []int{0, 1, 100} {
+		require.NoError(t, (&ListOptions{Limit: limit}).Validate())
+	}
+	require.EqualError(t, (&ListOptions{Limit: 101}).Validate(), "limit: must be between 0 (default) and 100 (inclusive), got 101")
+	require.EqualError(t, (&ListOptions{Limit: -1}).Validate(), "limit: must be between 0 (default) and 100 (inclusive), got -1")
+
+	require.NoError(t, (&ListModActionOptions{ListOptions: ListOptions{Limit: 500}}).Validate())
+	require.EqualError(t, (&ListModActionOptions{ListOptions: ListOptions{Limit: 501}}).Validate(), "limit: must be between 0 (default) and 500 (inclusive), got 501")
+}
+
+func TestListOptions_ValidateBeforeRequest(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request should be sent")
+	})
+	mux.HandleFunc("/user/user1/overview", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request should be sent")
+	})
+
+	_, _, err := client.Subreddit.HotPosts(ctx, "test", &ListOptions{Limit: 101})
+	require.EqualError(t, err, "limit: must be between 0 (default) and 100 (inclusive), got 101")
+
+	_, _, _, err = client.User.OverviewOf(ctx, "user1", &ListUserOverviewOptions{ListOptions: ListOptions{Limit: -1}})
+	require.EqualError(t, err, "limit: must be between 0 (default) and 100 (inclusive), got -1")
+}
+
+func TestPointerHelpers(t *testing.T) {
+	require.Equal(t, "test", *String("test"))
+	require.Equal(t, 1, *Int(1))
+	require.Equal(t, true, *Bool(true))
+	require.Equal(t, int64(1), *Int64(1))
+	require.Equal(t, float32(1.5), *Float32(1.5))
+	require.Equal(t, 1.5, *Float64(1.5))
+
+	require.Equal(t, "test", StringValue(String("test")))
+	require.Equal(t, 1, IntValue(Int(1)))
+	require.Equal(t, true, BoolValue(Bool(true)))
+	require.Equal(t, int64(1), Int64Value(Int64(1)))
+	require.Equal(t, float32(1.5), Float32Value(Float32(1.5)))
+	require.Equal(t, 1.5, Float64Value(Float64(1.5)))
+
+	require.Equal(t, "", StringValue(nil))
+	require.Equal(t, 0, IntValue(nil))
+	require.Equal(t, false, BoolValue(nil))
+	require.Equal(t, int64(0), Int64Value(nil))
+	require.Equal(t, float32(0), Float32Value(nil))
+	require.Equal(t, float64(0), Float64Value(nil))
+}
+
+func TestNewJSONForm(t *testing.T) {
+	require.Equal(t, url.Values{"api_type": {"json"}}, newJSONForm())
+
+	// each call returns new values
+	form := newJSONForm()
+	form.Set("id", "t3_test")
+	require.Equal(t, url.Values{"api_type": {"json"}}, newJSONForm())
+}
+
+func TestEncodeForm(t *testing.T) {
+	form, err := encodeForm(nil)
+	require.NoError(t, err)
+	require.Equal(t, url.Values{}, form)
+
+	form, err = encodeForm((*SendMessageRequest)(nil))
+	require.NoError(t, err)
+	require.Equal(t, url.Values{}, form)
+
+	form, err = encodeForm(&SendMessageRequest{To: "testuser", Subject: "test subject"})
+	require.NoError(t, err)
+	require.Equal(t, url.Values{
+		"to":      {"testuser"},
+		"subject": {"test subject"},
+		"text":    {""},
+	}, form)
+
+	_, err = encodeForm("test")
+	require.Error(t, err)
+}
+
+func TestEncodeJSONForm(t *testing.T) {
+	form, err := encodeJSONForm(&SendMessageRequest{To: "testuser", Subject: "test subject", Text: "test text", FromSubreddit: "testsubreddit"})
+	require.NoError(t, err)
+	require.Equal(t, url.Values{
+		"api_type": {"json"},
+		"to":       {"testuser"},
+		"subject":  {"test subject"},
+		"text":     {"test text"},
+		"from_sr":  {"testsubreddit"},
+	}, form)
+
+	form, err = encodeJSONForm(nil)
+	require.NoError(t, err)
+	require.Equal(t, url.Values{"api_type": {"json"}}, form)
+
+	_, err = encodeJSONForm(1)
+	require.Error(t, err)
+}
@@ -0,0 +1,179 @@
+package reddit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// SearchService handles communication with the search
+// related methods of the Reddit API.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_search
+type SearchService struct {
+	client *Client
+}
+
+// SearchResults holds the posts, subreddits and users returned by a search.
+type SearchResults struct {
+	Posts      *Posts
+	Subreddits *Subreddits
+	Users      []*User
+}
+
+// rootSearchResults decodes the response of a search. Reddit returns a single listing
+// when searching for one type of thing, and an array of listings when searching for several.
+type rootSearchResults struct {
+	listings []rootListing
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *rootSearchResults) UnmarshalJSON(b []byte) error {
+	if len(bytes.TrimSpace(b)) > 0 && bytes.TrimSpace(b)[0] == '[' {
+		return json.Unmarshal(b, &r.listings)
+	}
+
+	var l rootListing
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+	r.listings = []rootListing{l}
+	return nil
+}
+
+func (r *rootSearchResults) getResults() *SearchResults {
+	results := &SearchResults{
+		Posts:      &Posts{},
+		Subreddits: &Subreddits{},
+	}
+
+	for _, l := range r.listings {
+		if len(l.Data.Things.Posts) > 0 {
+			results.Posts = l.getPosts()
+		}
+		if len(l.Data.Things.Subreddits) > 0 {
+			results.Subreddits = l.getSubreddits()
+		}
+		results.Users = append(results.Users, l.Data.Things.Users...)
+	}
+
+	return results
+}
+
+// Everything searches all of Reddit for posts, subreddits and users matching the query, in a single request.
+// The types of things searched for can be restricted via opts.Type.
+func (s *SearchService) Everything(ctx context.Context, query string, opts *SearchEverythingOptions) (*SearchResults, *Response, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil, ErrEmptyQuery
+	}
+
+	path, err := addOptions("search", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type params struct {
+		Query string `url:"q"`
+	}
+	path, err = addOptions(path, params{query})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootSearchResults)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.getResults(), resp, nil
+}
+
+// QueryBuilder builds a search query using Reddit's search operators, e.g. author:username.
+// The zero value is an empty query, and each method returns the builder so calls can be chained:
+//
+//	q := new(reddit.QueryBuilder).Keywords("release").Author("golang").NSFW(false).Build()
+//	posts, _, err := client.Subreddit.SearchPosts(ctx, q, "golang", nil)
+type QueryBuilder struct {
+	terms []string
+}
+
+func (b *QueryBuilder) operator(name, value string) *QueryBuilder {
+	b.terms = append(b.terms, name+":"+quoteSearchTerm(value))
+	return b
+}
+
+// Keywords adds terms that must appear in the results.
+// Terms containing spaces are searched for as phrases.
+func (b *QueryBuilder) Keywords(terms ...string) *QueryBuilder {
+	for _, term := range terms {
+		if term != "" {
+			b.terms = append(b.terms, quoteSearchTerm(term))
+		}
+	}
+	return b
+}
+
+// Author restricts the results to posts submitted by the user.
+func (b *QueryBuilder) Author(username string) *QueryBuilder {
+	return b.operator("author", username)
+}
+
+// Subreddit restricts the results to posts in the subreddit.
+func (b *QueryBuilder) Subreddit(name string) *QueryBuilder {
+	return b.operator("subreddit", name)
+}
+
+// Flair restricts the results to posts with the flair text.
+func (b *QueryBuilder) Flair(text string) *QueryBuilder {
+	return b.operator("flair", text)
+}
+
+// Site restricts the results to link posts pointing to the domain.
+func (b *QueryBuilder) Site(domain string) *QueryBuilder {
+	return b.operator("site", domain)
+}
+
+// URL restricts the results to link posts whose URL matches the pattern.
+func (b *QueryBuilder) URL(pattern string) *QueryBuilder {
+	return b.operator("url", pattern)
+}
+
+// Selftext restricts the results to posts whose text contains the term.
+func (b *QueryBuilder) Selftext(term string) *QueryBuilder {
+	return b.operator("selftext", term)
+}
+
+// NSFW restricts the results to posts that are, or are not, marked NSFW.
+func (b *QueryBuilder) NSFW(v bool) *QueryBuilder {
+	if v {
+		return b.operator("nsfw", "yes")
+	}
+	return b.operator("nsfw", "no")
+}
+
+// Build returns the query string, to be passed to a search method such as SubredditService.SearchPosts.
+func (b *QueryBuilder) Build() string {
+	return strings.Join(b.terms, " ")
+}
+
+// String implements the fmt.Stringer interface.
+func (b *QueryBuilder) String() string {
+	return b.Build()
+}
+
+// quoteSearchTerm quotes the term if it contains characters that would split it into several terms.
+func quoteSearchTerm(term string) string {
+	if strings.ContainsAny(term, " \t\n\"") {
+		return strconv.Quote(term)
+	}
+	return term
+}
@@ -0,0 +1,122 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchService_Everything(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/search/everything.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "golang")
+		form.Set("sort", "new")
+		form.Set("t", "week")
+		form.Set("type", "link,sr,user")
+		form.Set("include_over_18", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Search.Everything(ctx, "", nil)
+	require.ErrorIs(t, err, ErrEmptyQuery)
+
+	results, _, err := client.Search.Everything(ctx, "golang", &SearchEverythingOptions{
+		Sort:        SortNew,
+		Time:        TimeFilterWeek,
+		Type:        "link,sr,user",
+		IncludeNSFW: Bool(true),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, results.Posts.Posts, 1)
+	require.Equal(t, "t3_hqabc1", results.Posts.Posts[0].FullID)
+	require.Equal(t, "Go 1.21 is released", results.Posts.Posts[0].Title)
+	require.Equal(t, "t3_hqabc1", results.Posts.After)
+
+	require.Len(t, results.Subreddits.Subreddits, 1)
+	require.Equal(t, "golang", results.Subreddits.Subreddits[0].Name)
+	require.Equal(t, 235716, results.Subreddits.Subreddits[0].Subscribers)
+
+	require.Len(t, results.Users, 1)
+	require.Equal(t, "golang_user", results.Users[0].Name)
+	require.Equal(t, 10, results.Users[0].PostKarma)
+}
+
+func TestSearchService_Everything_SingleListing(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	results, _, err := client.Search.Everything(ctx, "golang", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, results.Posts.Posts)
+	require.Empty(t, results.Subreddits.Subreddits)
+	require.Empty(t, results.Users)
+}
+
+func TestQueryBuilder(t *testing.T) {
+	require.Equal(t, "", new(QueryBuilder).Build())
+
+	q := new(QueryBuilder).
+		Keywords("golang", "", "generics release").
+		Author("test_user").
+		Subreddit("golang").
+		Flair("Show & Tell").
+		Site("go.dev").
+		URL("blog").
+		Selftext(`say "hi"`).
+		NSFW(false)
+	require.Equal(t, `golang "generics release" author:test_user subreddit:golang flair:"Show & Tell" site:go.dev url:blog selftext:"say \"hi\"" nsfw:no`, q.Build())
+	require.Equal(t, q.Build(), q.String())
+
+	require.Equal(t, "nsfw:yes", new(QueryBuilder).NSFW(true).Build())
+}
+
+func TestQueryBuilder_SearchPosts(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", `author:test_user flair:"Show & Tell"`)
+		form.Set("restrict_sr", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	q := new(QueryBuilder).Author("test_user").Flair("Show & Tell")
+	_, _, err = client.Subreddit.SearchPosts(ctx, q.Build(), "golang", nil)
+	require.NoError(t, err)
+}
@@ -1,7 +1,9 @@
 package reddit
 
 import (
+	"container/list"
 	"context"
+	"sort"
 	"sync"
 	"time"
 )
@@ -19,14 +21,7 @@ type StreamService struct {
 // Because of the 100 post limit imposed by Reddit when fetching posts, some high-traffic
 // streams might drop submissions between API requests, such as when streaming r/all.
 func (s *StreamService) Posts(subreddit string, opts ...StreamOpt) (<-chan *Post, <-chan error, func()) {
-	streamConfig := &streamConfig{
-		Interval:       defaultStreamInterval,
-		DiscardInitial: false,
-		MaxRequests:    0,
-	}
-	for _, opt := range opts {
-		opt(streamConfig)
-	}
+	streamConfig := newStreamConfig(0, opts)
 
 	ticker := time.NewTicker(streamConfig.Interval)
 	posts := make(chan *Post)
@@ -113,3 +108,206 @@ func (s set) Exists(v string) bool {
 	_, ok := s[v]
 	return ok
 }
+
+// Stream streams new posts from the specified subreddit until ctx is cancelled, at which point
+// both channels are closed. Posts are sent oldest first, and each post is sent at most once.
+// Errors are sent on the error channel without stopping the stream, so the caller can decide
+// whether to cancel it. The post channel must be read from, or the stream will block. Reading
+// from the error channel is optional: errors that don't fit in its buffer are dropped.
+// It takes the same options as StreamService.Posts, as well as StreamBufferSize. If the stream
+// stops because of StreamMaxRequests, both channels are closed too.
+func (s *SubredditService) Stream(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Post, <-chan error) {
+	fetch := func(ctx context.Context) ([]*Post, error) {
+		result, _, err := s.NewPosts(ctx, subreddit, &ListOptions{Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		return result.Posts, nil
+	}
+	fullID := func(p *Post) string { return p.FullID }
+	return pollStream(ctx, newStreamConfig(0, opts), fetch, fullID, nil)
+}
+
+// CommentStream streams new comments from the specified subreddit until ctx is cancelled, at which point
+// both channels are closed. The subreddit can be "all" to stream comments from all of Reddit.
+// It behaves like Stream, except that the channels are buffered to 100 items unless set otherwise.
+func (s *SubredditService) CommentStream(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Comment, <-chan error) {
+	fetch := func(ctx context.Context) ([]*Comment, error) {
+		result, _, err := s.Comments(ctx, subreddit, &ListOptions{Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		return result.Comments, nil
+	}
+	fullID := func(c *Comment) string { return c.FullID }
+	return pollStream(ctx, newStreamConfig(defaultCommentStreamBufferSize, opts), fetch, fullID, nil)
+}
+
+// Stream streams new unread comments and messages from your inbox, e.g. username mentions, comment
+// replies and private messages, until ctx is cancelled, at which point both channels are closed.
+// They are sent oldest first, and each is sent at most once. With StreamMarkRead, each one is
+// marked as read after being sent on the channel. Errors are sent on the error channel without
+// stopping the stream, which waits longer after each consecutive failure before retrying.
+// It takes the same options as SubredditService.Stream.
+func (s *MessageService) Stream(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error) {
+	fetch := func(ctx context.Context) ([]*Message, error) {
+		comments, messages, _, err := s.InboxUnread(ctx, &ListOptions{Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+
+		result := append(comments.Messages, messages.Messages...)
+		// newest first, like the other listings
+		sort.SliceStable(result, func(i, j int) bool {
+			ci, cj := result[i].Created, result[j].Created
+			if ci == nil || cj == nil {
+				return cj == nil && ci != nil
+			}
+			return cj.Before(*ci)
+		})
+		return result, nil
+	}
+	fullID := func(m *Message) string { return m.FullID }
+
+	config := newStreamConfig(0, opts)
+
+	var delivered func(context.Context, *Message) error
+	if config.MarkRead {
+		delivered = func(ctx context.Context, m *Message) error {
+			_, err := s.Read(ctx, m.FullID)
+			return err
+		}
+	}
+
+	return pollStream(ctx, config, fetch, fullID, delivered)
+}
+
+// pollStream calls fetch at the configured interval until ctx is cancelled, sending the items
+// it hasn't seen yet, identified by their full ID. fetch returns items newest first.
+// If delivered is not nil, it is called after each item is sent, and its error, if any, is sent too.
+// After consecutive errors from fetch, the wait before the next attempt is doubled each time.
+func pollStream[T any](
+	ctx context.Context,
+	config *streamConfig,
+	fetch func(context.Context) ([]T, error),
+	fullID func(T) string,
+	delivered func(context.Context, T) error,
+) (<-chan T, <-chan error) {
+	items := make(chan T, config.BufferSize)
+	// errs is always buffered, so an error can be kept for a caller that isn't receiving at that moment.
+	errs := make(chan error, max(config.BufferSize, 1))
+
+	// sendErr never blocks: if errs is full, e.g. because the caller doesn't read from it,
+	// the error is dropped so the stream keeps going.
+	sendErr := func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(items)
+
+		seen := newLRU(streamCacheSize)
+		failures := 0
+		discard := config.DiscardInitial
+
+		for n := 1; ; n++ {
+			result, err := fetch(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				sendErr(err)
+				failures++
+			} else {
+				failures = 0
+			}
+
+			for i := len(result) - 1; i >= 0; i-- {
+				item := result[i]
+				if seen.Add(fullID(item)) || discard {
+					continue
+				}
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					return
+				}
+				if delivered == nil {
+					continue
+				}
+				if err := delivered(ctx, item); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					sendErr(err)
+				}
+			}
+			if err == nil {
+				discard = false
+			}
+
+			if config.MaxRequests > 0 && n >= config.MaxRequests {
+				return
+			}
+
+			timer := time.NewTimer(streamBackoff(config.Interval, failures))
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+
+	return items, errs
+}
+
+// streamBackoff returns how long to wait before the next fetch of a stream: the interval,
+// doubled for each consecutive failure, up to maxStreamBackoff.
+func streamBackoff(interval time.Duration, failures int) time.Duration {
+	wait := interval
+	for i := 0; i < failures && wait < maxStreamBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxStreamBackoff && interval < maxStreamBackoff {
+		wait = maxStreamBackoff
+	}
+	return wait
+}
+
+// lru is a set of strings holding at most size items. When full, adding
+// an item evicts the one that was least recently added or seen.
+type lru struct {
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// Add adds v to the set, and reports whether it was already present.
+func (l *lru) Add(v string) bool {
+	if e, ok := l.items[v]; ok {
+		l.order.MoveToFront(e)
+		return true
+	}
+
+	l.items[v] = l.order.PushFront(v)
+	if l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(string))
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -312,3 +313,361 @@ loop:
 
 	require.Len(t, expectedPostIDs, i)
 }
+
+func TestSubredditService_Stream(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post2"}},
+						{"kind": "t3", "data": {"name": "t3_post1"}}
+					]
+				}
+			}`)
+		case 1:
+			http.Error(w, `{"message": "Internal Server Error", "error": 500}`, http.StatusInternalServerError)
+		default:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post3"}},
+						{"kind": "t3", "data": {"name": "t3_post2"}}
+					]
+				}
+			}`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	posts, errs := client.Subreddit.Stream(ctx, "testsubreddit", StreamInterval(time.Millisecond*10))
+
+	var fullIDs []string
+	var gotErr bool
+	for len(fullIDs) < 3 {
+		select {
+		case post := <-posts:
+			fullIDs = append(fullIDs, post.FullID)
+		case err := <-errs:
+			require.Error(t, err)
+			gotErr = true
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for posts")
+		}
+	}
+
+	require.Equal(t, []string{"t3_post1", "t3_post2", "t3_post3"}, fullIDs)
+	require.True(t, gotErr)
+
+	cancel()
+
+	for range posts {
+	}
+	for range errs {
+	}
+}
+
+func TestSubredditService_Stream_UnreadErrors(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0, 1, 2:
+			http.Error(w, `{"message": "Internal Server Error", "error": 500}`, http.StatusInternalServerError)
+		default:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post2"}},
+						{"kind": "t3", "data": {"name": "t3_post1"}}
+					]
+				}
+			}`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// errs is never read from, which must not stop posts from being sent.
+	posts, _ := client.Subreddit.Stream(ctx, "testsubreddit", StreamInterval(time.Millisecond))
+
+	var fullIDs []string
+	for len(fullIDs) < 2 {
+		select {
+		case post := <-posts:
+			fullIDs = append(fullIDs, post.FullID)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for posts")
+		}
+	}
+
+	require.Equal(t, []string{"t3_post1", "t3_post2"}, fullIDs)
+}
+
+func TestSubredditService_Stream_Options(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post2"}},
+						{"kind": "t3", "data": {"name": "t3_post1"}}
+					]
+				}
+			}`)
+		default:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post3"}},
+						{"kind": "t3", "data": {"name": "t3_post2"}}
+					]
+				}
+			}`)
+		}
+	})
+
+	posts, errs := client.Subreddit.Stream(ctx, "testsubreddit",
+		StreamInterval(time.Millisecond),
+		StreamDiscardInitial,
+		StreamMaxRequests(3),
+		StreamBufferSize(10),
+	)
+	require.Equal(t, 10, cap(posts))
+
+	// the posts of the first fetch are discarded, and the channels are closed after the 3rd fetch
+	var fullIDs []string
+	timeout := time.After(time.Second)
+	for posts != nil {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				posts = nil
+				continue
+			}
+			fullIDs = append(fullIDs, post.FullID)
+		case <-timeout:
+			t.Fatal("timed out waiting for the stream to stop")
+		}
+	}
+
+	require.Equal(t, []string{"t3_post3"}, fullIDs)
+	require.Equal(t, 3, counter)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestSubredditService_CommentStream(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/r/all/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		if counter == 0 {
+			fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t1", "data": {"name": "t1_comment1", "body": "hello"}}
+				]
+			}
+		}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comments, errs := client.Subreddit.CommentStream(ctx, "all", StreamInterval(time.Millisecond*10))
+	require.Equal(t, 100, cap(comments))
+
+	select {
+	case comment := <-comments:
+		require.Equal(t, "t1_comment1", comment.FullID)
+		require.Equal(t, "hello", comment.Body)
+	case err := <-errs:
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for comment")
+	}
+
+	// the same comment is returned by subsequent polls, but must not be sent again
+	select {
+	case comment := <-comments:
+		t.Fatalf("unexpected comment: %s", comment.FullID)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	cancel()
+
+	for range comments {
+	}
+	for range errs {
+	}
+}
+
+func TestSubredditService_Comments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/golang/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t1", "data": {"name": "t1_comment2"}},
+					{"kind": "t1", "data": {"name": "t1_comment1"}}
+				],
+				"after": "t1_comment1"
+			}
+		}`)
+	})
+
+	_, _, err := client.Subreddit.Comments(ctx, "", nil)
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	comments, _, err := client.Subreddit.Comments(ctx, "golang", &ListOptions{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, comments.Comments, 2)
+	require.Equal(t, "t1_comment2", comments.Comments[0].FullID)
+	require.Equal(t, "t1_comment1", comments.After)
+}
+
+func TestMessageService_Stream(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/message/unread", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		if counter == 0 {
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_comment1", "was_comment": true, "created_utc": 1595000100}},
+						{"kind": "t4", "data": {"name": "t4_message1", "created_utc": 1595000000}}
+					]
+				}
+			}`)
+			return
+		}
+
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t4", "data": {"name": "t4_message2", "created_utc": 1595000200}},
+					{"kind": "t1", "data": {"name": "t1_comment1", "was_comment": true, "created_utc": 1595000100}}
+				]
+			}
+		}`)
+	})
+
+	read := make(chan string, 3)
+	mux.HandleFunc("/api/read_message", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		read <- r.PostForm.Get("id")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	messages, errs := client.Message.Stream(ctx, StreamInterval(time.Millisecond*10), StreamMarkRead)
+
+	var fullIDs []string
+	for len(fullIDs) < 3 {
+		select {
+		case message := <-messages:
+			fullIDs = append(fullIDs, message.FullID)
+		case err := <-errs:
+			t.Fatalf("unexpected error: %v", err)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for messages")
+		}
+	}
+
+	require.Equal(t, []string{"t4_message1", "t1_comment1", "t4_message2"}, fullIDs)
+
+	for _, fullID := range fullIDs {
+		select {
+		case id := <-read:
+			require.Equal(t, fullID, id)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for message to be marked as read")
+		}
+	}
+
+	cancel()
+
+	for range messages {
+	}
+	for range errs {
+	}
+}
+
+func TestStreamBackoff(t *testing.T) {
+	require.Equal(t, time.Second, streamBackoff(time.Second, 0))
+	require.Equal(t, time.Second*2, streamBackoff(time.Second, 1))
+	require.Equal(t, time.Second*8, streamBackoff(time.Second, 3))
+	require.Equal(t, maxStreamBackoff, streamBackoff(time.Second, 20))
+	require.Equal(t, time.Hour, streamBackoff(time.Hour, 3))
+}
+
+func TestLRU(t *testing.T) {
+	l := newLRU(2)
+	require.False(t, l.Add("a"))
+	require.False(t, l.Add("b"))
+	require.True(t, l.Add("a"))
+
+	// "b" is the least recently seen, so it's evicted
+	require.False(t, l.Add("c"))
+	require.True(t, l.Add("a"))
+	require.False(t, l.Add("b"))
+}
@@ -4,10 +4,31 @@ import "time"
 
 const defaultStreamInterval = time.Second * 5
 
+const (
+	streamCacheSize                = 1000
+	defaultCommentStreamBufferSize = 100
+	maxStreamBackoff               = time.Minute * 5
+)
+
 type streamConfig struct {
 	Interval       time.Duration
 	DiscardInitial bool
 	MaxRequests    int
+	BufferSize     int
+	MarkRead       bool
+}
+
+// newStreamConfig returns the configuration of a stream with the options applied,
+// defaulting to a channel buffer of bufferSize.
+func newStreamConfig(bufferSize int, opts []StreamOpt) *streamConfig {
+	c := &streamConfig{
+		Interval:   defaultStreamInterval,
+		BufferSize: bufferSize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // StreamOpt is a configuration option to configure a stream.
@@ -38,6 +59,23 @@ func StreamMaxRequests(v int) StreamOpt {
 	}
 }
 
+// StreamBufferSize sets the buffer size of the channels of a stream started with SubredditService.Stream,
+// SubredditService.CommentStream or MessageService.Stream. Their error channel is always buffered to at least 1 item.
+// If less than or equal to 0, it will not be set and the default will be used.
+func StreamBufferSize(v int) StreamOpt {
+	return func(c *streamConfig) {
+		if v > 0 {
+			c.BufferSize = v
+		}
+	}
+}
+
+// StreamMarkRead marks each message streamed by MessageService.Stream as read after it is sent on the channel.
+// Other streams ignore it.
+func StreamMarkRead(c *streamConfig) {
+	c.MarkRead = true
+}
+
 // Streamer streams data to the client.
 // type Streamer interface {
 // 	Stream() (<-chan *rootListing, <-chan error, func())
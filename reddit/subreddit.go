@@ -1,11 +1,16 @@
 package reddit
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 )
 
@@ -42,6 +47,12 @@ type Relationships struct {
 	Before        string          `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of relationships,
+// or nil if there is no next page.
+func (r *Relationships) NextPageOptions() *ListOptions {
+	return nextPageOptions(r.After)
+}
+
 // Moderator is a user who moderates a subreddit.
 type Moderator struct {
 	*Relationship
@@ -63,6 +74,181 @@ type Bans struct {
 	Before string `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of bans,
+// or nil if there is no next page.
+func (b *Bans) NextPageOptions() *ListOptions {
+	return nextPageOptions(b.After)
+}
+
+// Stylesheet contains the subreddit's CSS and the images it references.
+type Stylesheet struct {
+	SubredditID string             `json:"subreddit_id"`
+	Images      []*StylesheetImage `json:"images"`
+	Stylesheet  string             `json:"stylesheet"`
+}
+
+// StylesheetImage is an image uploaded to a subreddit's stylesheet.
+type StylesheetImage struct {
+	Name string `json:"name"`
+	// The placeholder used to reference the image in the CSS, e.g. url(%%name%%).
+	Link string `json:"link"`
+	// The URL where the image is hosted.
+	URL string `json:"url"`
+}
+
+// SubmissionText is the text set by a subreddit's moderators to be displayed on the submission form.
+type SubmissionText struct {
+	Markdown string `json:"submit_text"`
+	// Empty if the subreddit has no submission text.
+	HTML string `json:"submit_text_html"`
+}
+
+// SubredditRules holds the rules of a subreddit, along with Reddit's site-wide rules.
+type SubredditRules struct {
+	Rules []*Rule `json:"rules,omitempty"`
+	// The site-wide reasons that can be used to report something.
+	SiteRules []string `json:"site_rules,omitempty"`
+	// The steps of the site-wide reporting flow, as shown when reporting something on Reddit.
+	SiteRulesFlow []*SiteRuleFlow `json:"site_rules_flow,omitempty"`
+}
+
+// Rule is a rule of a subreddit.
+type Rule struct {
+	ShortName       string `json:"short_name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DescriptionHTML string `json:"description_html,omitempty"`
+	// One of: link, comment, all.
+	Kind string `json:"kind,omitempty"`
+	// The reason shown when the rule is used to report something.
+	// It can be passed as is to Post.Report and Comment.Report.
+	ViolationReason string     `json:"violation_reason,omitempty"`
+	CreatedAt       *Timestamp `json:"created_utc,omitempty"`
+	// The position of the rule in the subreddit's list of rules, starting at 0.
+	Priority int `json:"priority"`
+}
+
+// SiteRuleFlow is a step of Reddit's site-wide reporting flow.
+// A step either has more specific reasons to choose from, or leads to filing a complaint.
+type SiteRuleFlow struct {
+	ReasonTextToShow string `json:"reasonTextToShow,omitempty"`
+	// The reason to use when reporting something for this step.
+	ReasonText string `json:"reasonText,omitempty"`
+
+	NextStepHeader  string          `json:"nextStepHeader,omitempty"`
+	NextStepReasons []*SiteRuleFlow `json:"nextStepReasons,omitempty"`
+
+	// Whether the report must be made through a complaint form instead, e.g. for copyright infringement.
+	FileComplaint       bool   `json:"fileComplaint"`
+	ComplaintPageTitle  string `json:"complaintPageTitle,omitempty"`
+	ComplaintButtonText string `json:"complaintButtonText,omitempty"`
+	ComplaintURL        string `json:"complaintUrl,omitempty"`
+}
+
+// ModNoteLabel is the label of a mod note, used to categorize the user it is about.
+type ModNoteLabel string
+
+// Possible mod note labels.
+const (
+	ModNoteLabelBotBan           ModNoteLabel = "BOT_BAN"
+	ModNoteLabelPermaBan         ModNoteLabel = "PERMA_BAN"
+	ModNoteLabelBan              ModNoteLabel = "BAN"
+	ModNoteLabelAbuseWarning     ModNoteLabel = "ABUSE_WARNING"
+	ModNoteLabelSpamWarning      ModNoteLabel = "SPAM_WARNING"
+	ModNoteLabelSpamWatch        ModNoteLabel = "SPAM_WATCH"
+	ModNoteLabelSolidContributor ModNoteLabel = "SOLID_CONTRIBUTOR"
+	ModNoteLabelHelpfulUser      ModNoteLabel = "HELPFUL_USER"
+)
+
+// IsValid reports whether l is a known mod note label.
+func (l ModNoteLabel) IsValid() bool {
+	switch l {
+	case ModNoteLabelBotBan, ModNoteLabelPermaBan, ModNoteLabelBan, ModNoteLabelAbuseWarning,
+		ModNoteLabelSpamWarning, ModNoteLabelSpamWatch, ModNoteLabelSolidContributor, ModNoteLabelHelpfulUser:
+		return true
+	}
+	return false
+}
+
+// ModNote is a note left by a moderator about a user of a subreddit.
+type ModNote struct {
+	ID string `json:"id,omitempty"`
+	// One of NOTE for notes added by moderators, or the type of the mod action that created it, e.g. BAN.
+	Type string `json:"type,omitempty"`
+
+	Operator   string `json:"operator,omitempty"`
+	OperatorID string `json:"operator_id,omitempty"`
+
+	Label ModNoteLabel `json:"-"`
+	Note  string       `json:"-"`
+	// The full ID of the post or comment the note is about, if any.
+	RedditID string `json:"-"`
+
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+
+	UserID      string `json:"user_id,omitempty"`
+	Username    string `json:"user,omitempty"`
+	SubredditID string `json:"subreddit_id,omitempty"`
+	Subreddit   string `json:"subreddit,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *ModNote) UnmarshalJSON(data []byte) error {
+	type modNote ModNote
+	root := new(struct {
+		*modNote
+		UserNoteData struct {
+			Label    ModNoteLabel `json:"label"`
+			Note     string       `json:"note"`
+			RedditID string       `json:"reddit_id"`
+		} `json:"user_note_data"`
+	})
+	root.modNote = (*modNote)(n)
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	n.Label = root.UserNoteData.Label
+	n.Note = root.UserNoteData.Note
+	n.RedditID = root.UserNoteData.RedditID
+
+	return nil
+}
+
+// AddModNoteRequest represents a request to add a note about a user of a subreddit.
+type AddModNoteRequest struct {
+	Subreddit string `url:"subreddit"`
+	Username  string `url:"user"`
+	// At most 250 characters.
+	Note string `url:"note"`
+	// Optional.
+	Label ModNoteLabel `url:"label,omitempty"`
+	// Optional. The full ID of a post or comment to link the note to.
+	RedditID string `url:"reddit_id,omitempty"`
+}
+
+func (r *AddModNoteRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("subreddit: cannot be empty")
+	}
+	if r.Username == "" {
+		return errors.New("username: cannot be empty")
+	}
+	if r.Note == "" {
+		return errors.New("note: cannot be empty")
+	}
+	if r.Label != "" && !r.Label.IsValid() {
+		return fmt.Errorf("label: unknown mod note label %q", r.Label)
+	}
+	if r.RedditID != "" {
+		if err := validateFullID(r.RedditID, kindComment, kindPost); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // todo: interface{}, seriously?
 func (s *SubredditService) getPosts(ctx context.Context, sort string, subreddit string, opts interface{}) (*Posts, *Response, error) {
 	path := sort
@@ -136,6 +322,34 @@ func (s *SubredditService) TopPosts(ctx context.Context, subreddit string, opts
 	return s.getPosts(ctx, "top", subreddit, opts)
 }
 
+// Comments returns the newest comments from the specified subreddit.
+// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+// To search through all, just specify "all".
+func (s *SubredditService) Comments(ctx context.Context, subreddit string, opts *ListOptions) (*Comments, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/comments", subreddit)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.getComments(), resp, nil
+}
+
 // Get gets a subreddit by name.
 func (s *SubredditService) Get(ctx context.Context, name string) (*Subreddit, *Response, error) {
 	if name == "" {
@@ -157,6 +371,92 @@ func (s *SubredditService) Get(ctx context.Context, name string) (*Subreddit, *R
 	return root.Data, resp, nil
 }
 
+// GetMultipleByName gets subreddits by name, in the order in which the names were provided.
+// Names are matched case-insensitively and duplicates are ignored. Subreddits that could not
+// be found, e.g. banned or private ones, are left out.
+// Requests are made in batches of 100 names, which is the limit imposed by Reddit.
+func (s *SubredditService) GetMultipleByName(ctx context.Context, names ...string) ([]*Subreddit, *Response, error) {
+	if len(names) == 0 {
+		return nil, nil, errors.New("must provide at least 1 name")
+	}
+
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(name)
+	}
+	lowered = dedupe(lowered)
+
+	subredditMap := make(map[string]*Subreddit, len(lowered))
+
+	var resp *Response
+	for start := 0; start < len(lowered); start += maxInfoIDs {
+		end := start + maxInfoIDs
+		if end > len(lowered) {
+			end = len(lowered)
+		}
+
+		type params struct {
+			Names string `url:"sr_name"`
+		}
+
+		path, err := addOptions("api/info", params{strings.Join(lowered[start:end], ",")})
+		if err != nil {
+			return nil, resp, err
+		}
+
+		req, err := s.client.NewRequest(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		root := new(rootListing)
+		resp, err = s.client.Do(ctx, req, root)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, subreddit := range root.Data.Things.Subreddits {
+			subredditMap[strings.ToLower(subreddit.Name)] = subreddit
+		}
+	}
+
+	var subreddits []*Subreddit
+	for _, name := range lowered {
+		if subreddit, ok := subredditMap[name]; ok {
+			subreddits = append(subreddits, subreddit)
+		}
+	}
+
+	return subreddits, resp, nil
+}
+
+// GetRelated returns the subreddits Reddit considers related to the given one.
+// Reddit returns a fixed list, so the results are not paginated.
+// If the subreddit does not exist, the error matches ErrSubredditNotFound.
+// Note: this endpoint is not listed in Reddit's API docs, so it may change or stop working without notice.
+func (s *SubredditService) GetRelated(ctx context.Context, subreddit string) ([]*Subreddit, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/related", subreddit)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if errors.Is(err, ErrNotFound) {
+		return nil, resp, fmt.Errorf("%w: %w", ErrSubredditNotFound, err)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data.Things.Subreddits, resp, nil
+}
+
 // Popular returns popular subreddits.
 func (s *SubredditService) Popular(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/popular", opts)
@@ -193,14 +493,70 @@ func (s *SubredditService) Moderated(ctx context.Context, opts *ListSubredditOpt
 	return s.getSubreddits(ctx, "subreddits/mine/moderator", opts)
 }
 
+// AllSubscribed returns every subreddit you are subscribed to, going through all the pages.
+// At most 10,000 subreddits are returned. Use a context with a deadline to bound how long it runs for.
+// The returned response is the one of the last page.
+func (s *SubredditService) AllSubscribed(ctx context.Context) ([]*Subreddit, *Response, error) {
+	return s.getAllSubreddits(ctx, "subreddits/mine/subscriber")
+}
+
+// AllApproved returns every subreddit you are an approved user in, going through all the pages.
+// At most 10,000 subreddits are returned. Use a context with a deadline to bound how long it runs for.
+// The returned response is the one of the last page.
+func (s *SubredditService) AllApproved(ctx context.Context) ([]*Subreddit, *Response, error) {
+	return s.getAllSubreddits(ctx, "subreddits/mine/contributor")
+}
+
+// AllModerated returns every subreddit you are a moderator of, going through all the pages.
+// At most 10,000 subreddits are returned. Use a context with a deadline to bound how long it runs for.
+// The returned response is the one of the last page.
+func (s *SubredditService) AllModerated(ctx context.Context) ([]*Subreddit, *Response, error) {
+	return s.getAllSubreddits(ctx, "subreddits/mine/moderator")
+}
+
+// GetSticky returns one of the 2 stickied posts on a subreddit (if it exists).
+// The position must be 1 for the first (top) sticky, or 2 for the second (bottom) one.
+func (s *SubredditService) GetSticky(ctx context.Context, subreddit string, position int) (*PostAndComments, *Response, error) {
+	if position != 1 && position != 2 {
+		return nil, nil, fmt.Errorf("position: must be 1 or 2, got %d", position)
+	}
+
+	type params struct {
+		Num int `url:"num"`
+	}
+
+	path := fmt.Sprintf("r/%s/about/sticky", subreddit)
+	path, err := addOptions(path, params{position})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PostAndComments)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
 // GetSticky1 returns the first stickied post on a subreddit (if it exists).
+//
+// Deprecated: Use GetSticky with position 1 instead.
 func (s *SubredditService) GetSticky1(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
-	return s.getSticky(ctx, subreddit, 1)
+	return s.GetSticky(ctx, subreddit, 1)
 }
 
 // GetSticky2 returns the second stickied post on a subreddit (if it exists).
+//
+// Deprecated: Use GetSticky with position 2 instead.
 func (s *SubredditService) GetSticky2(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
-	return s.getSticky(ctx, subreddit, 2)
+	return s.GetSticky(ctx, subreddit, 2)
 }
 
 func (s *SubredditService) handleSubscription(ctx context.Context, form url.Values) (*Response, error) {
@@ -214,11 +570,21 @@ func (s *SubredditService) handleSubscription(ctx context.Context, form url.Valu
 	return s.client.Do(ctx, req, nil)
 }
 
+// SubscribeOptions are options used when subscribing to subreddits.
+type SubscribeOptions struct {
+	// If true, Reddit will not automatically subscribe the user to the default subreddits.
+	SkipInitialDefaults bool
+}
+
 // Subscribe subscribes to subreddits based on their names.
-func (s *SubredditService) Subscribe(ctx context.Context, subreddits ...string) (*Response, error) {
+// opts can be nil.
+func (s *SubredditService) Subscribe(ctx context.Context, opts *SubscribeOptions, subreddits ...string) (*Response, error) {
 	form := url.Values{}
 	form.Set("action", "sub")
 	form.Set("sr_name", strings.Join(subreddits, ","))
+	if opts != nil && opts.SkipInitialDefaults {
+		form.Set("skip_initial_defaults", "true")
+	}
 	return s.handleSubscription(ctx, form)
 }
 
@@ -250,10 +616,9 @@ func (s *SubredditService) UnsubscribeByID(ctx context.Context, ids ...string) (
 func (s *SubredditService) Favorite(ctx context.Context, subreddit string) (*Response, error) {
 	path := "api/favorite"
 
-	form := url.Values{}
+	form := newJSONForm()
 	form.Set("sr_name", subreddit)
 	form.Set("make_favorite", "true")
-	form.Set("api_type", "json")
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -267,10 +632,9 @@ func (s *SubredditService) Favorite(ctx context.Context, subreddit string) (*Res
 func (s *SubredditService) Unfavorite(ctx context.Context, subreddit string) (*Response, error) {
 	path := "api/favorite"
 
-	form := url.Values{}
+	form := newJSONForm()
 	form.Set("sr_name", subreddit)
 	form.Set("make_favorite", "false")
-	form.Set("api_type", "json")
 
 	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
 	if err != nil {
@@ -328,9 +692,87 @@ func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]str
 	return root.Names, resp, nil
 }
 
+// SearchUsers searches for users by name.
+// Unlike SearchNames, which only returns subreddit names, this returns user accounts.
+func (s *SubredditService) SearchUsers(ctx context.Context, query string, opts *ListOptions) ([]*User, *Response, error) {
+	users, resp, err := s.client.User.Search(ctx, query, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return users.Users, resp, nil
+}
+
+// Autocomplete returns the subreddits with names matching the query, for search-as-you-type.
+// Unlike SearchNames, the subreddits include e.g. their subscriber count and icon.
+// If includeProfiles is true, the subreddits of users' profiles are included too.
+func (s *SubredditService) Autocomplete(ctx context.Context, query string, includeProfiles bool) ([]*Subreddit, *Response, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil, ErrEmptyQuery
+	}
+
+	type params struct {
+		Query           string `url:"query"`
+		IncludeProfiles bool   `url:"include_profiles"`
+	}
+
+	path, err := addOptions("api/subreddit_autocomplete_v2", params{query, includeProfiles})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.getSubreddits().Subreddits, resp, nil
+}
+
+// Recommended returns the names of subreddits recommended based on the provided ones.
+// The subreddits in omit are excluded from the results.
+func (s *SubredditService) Recommended(ctx context.Context, subreddits []string, omit []string) ([]string, *Response, error) {
+	if len(subreddits) == 0 {
+		return nil, nil, errors.New("must provide at least 1 subreddit")
+	}
+
+	path := fmt.Sprintf("api/recommend/sr/%s", strings.Join(subreddits, ","))
+	if len(omit) > 0 {
+		params := url.Values{}
+		params.Set("omit", strings.Join(omit, ","))
+		path += "?" + params.Encode()
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root []struct {
+		Name string `json:"sr_name"`
+	}
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := make([]string, 0, len(root))
+	for _, sr := range root {
+		names = append(names, sr.Name)
+	}
+
+	return names, resp, nil
+}
+
 // SearchPosts searches for posts in the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If no subreddit is provided, the search is run against r/all.
+// Queries using search operators, e.g. author:username, can be built with a QueryBuilder.
 func (s *SubredditService) SearchPosts(ctx context.Context, query string, subreddit string, opts *ListPostSearchOptions) (*Posts, *Response, error) {
 	if subreddit == "" {
 		subreddit = "all"
@@ -387,31 +829,34 @@ func (s *SubredditService) getSubreddits(ctx context.Context, path string, opts
 	return root.getSubreddits(), resp, nil
 }
 
-// getSticky returns one of the 2 stickied posts of the subreddit (if they exist).
-// Num should be equal to 1 or 2, depending on which one you want.
-func (s *SubredditService) getSticky(ctx context.Context, subreddit string, num int) (*PostAndComments, *Response, error) {
-	type params struct {
-		Num int `url:"num"`
-	}
-
-	path := fmt.Sprintf("r/%s/about/sticky", subreddit)
-	path, err := addOptions(path, params{num})
-	if err != nil {
-		return nil, nil, err
+// maxAllSubreddits is the most subreddits returned by the All* methods,
+// to guard against paginating forever.
+const maxAllSubreddits = 10000
+
+func (s *SubredditService) getAllSubreddits(ctx context.Context, path string) ([]*Subreddit, *Response, error) {
+	var resp *Response
+	it := SubredditIterator(func(ctx context.Context, after string) (*Subreddits, *Response, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		var page *Subreddits
+		var err error
+		page, resp, err = s.getSubreddits(ctx, path, &ListSubredditOptions{ListOptions: ListOptions{Limit: 100, After: after}})
+		return page, resp, err
+	}, &IteratorOptions{MaxItems: maxAllSubreddits})
+
+	var subreddits []*Subreddit
+	for {
+		subreddit, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			return subreddits, resp, nil
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		subreddits = append(subreddits, subreddit)
 	}
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	root := new(PostAndComments)
-	resp, err := s.client.Do(ctx, req, root)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return root, resp, nil
 }
 
 // todo: sr_detail's NSFW indicator is over_18 instead of over18
@@ -471,25 +916,54 @@ func (s *SubredditService) RandomNSFW(ctx context.Context) (*Subreddit, *Respons
 // SubmissionText gets the submission text for the subreddit.
 // This text is set by the subreddit moderators and intended to be displayed on the submission form.
 func (s *SubredditService) SubmissionText(ctx context.Context, name string) (string, *Response, error) {
+	text, resp, err := s.SubmissionTextWithHTML(ctx, name)
+	if err != nil {
+		return "", resp, err
+	}
+	return text.Markdown, resp, nil
+}
+
+// SubmissionTextWithHTML gets the submission text for the subreddit, both as markdown and rendered as HTML.
+func (s *SubredditService) SubmissionTextWithHTML(ctx context.Context, name string) (*SubmissionText, *Response, error) {
 	if name == "" {
-		return "", nil, errors.New("name: cannot be empty")
+		return nil, nil, errors.New("name: cannot be empty")
 	}
 
 	path := fmt.Sprintf("r/%s/api/submit_text", name)
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
-	root := new(struct {
-		Text string `json:"submit_text"`
-	})
+	root := new(SubmissionText)
 	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
-		return "", resp, err
+		return nil, resp, err
 	}
 
-	return root.Text, resp, err
+	return root, resp, nil
+}
+
+// GetAboutRules gets the rules of the subreddit, along with Reddit's site-wide rules.
+// The violation reasons of the rules are the ones used to report posts and comments in the subreddit.
+func (s *SubredditService) GetAboutRules(ctx context.Context, subreddit string) (*SubredditRules, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/about/rules", subreddit)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(SubredditRules)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
 }
 
 // Banned gets banned users from the subreddit.
@@ -688,3 +1162,216 @@ func (s *SubredditService) Moderators(ctx context.Context, subreddit string) ([]
 
 	return root.Data.Moderators, resp, nil
 }
+
+// Stylesheet returns the subreddit's stylesheet, i.e. its CSS and the images it references.
+func (s *SubredditService) Stylesheet(ctx context.Context, subreddit string) (*Stylesheet, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/about/stylesheet", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data *Stylesheet `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+// GetStylesheet returns the CSS of the subreddit's stylesheet.
+// Use Stylesheet to also get the images it references.
+func (s *SubredditService) GetStylesheet(ctx context.Context, subreddit string) (string, *Response, error) {
+	stylesheet, resp, err := s.Stylesheet(ctx, subreddit)
+	if err != nil {
+		return "", resp, err
+	}
+	if stylesheet == nil {
+		return "", resp, nil
+	}
+	return stylesheet.Stylesheet, resp, nil
+}
+
+// UpdateStylesheet replaces the subreddit's stylesheet with the provided CSS.
+// It is the same as ModerationService.UpdateStylesheet.
+func (s *SubredditService) UpdateStylesheet(ctx context.Context, subreddit, css, reason string) (*Response, error) {
+	return s.client.Moderation.UpdateStylesheet(ctx, subreddit, css, reason)
+}
+
+// Widgets returns the widgets of the subreddit, i.e. the content of its sidebar and topbar.
+func (s *SubredditService) Widgets(ctx context.Context, subreddit string) (*Widgets, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/widgets", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Widgets)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetWikiPage gets a page of the subreddit's wiki. It is the same as WikiService.Page.
+func (s *SubredditService) GetWikiPage(ctx context.Context, subreddit, page string) (*WikiPage, *Response, error) {
+	return s.client.Wiki.Page(ctx, subreddit, page)
+}
+
+// EditWikiPage sets the content of a page of the subreddit's wiki. It is the same as WikiService.Edit.
+func (s *SubredditService) EditWikiPage(ctx context.Context, subreddit, page, content, reason string) (*Response, error) {
+	return s.client.Wiki.Edit(ctx, subreddit, page, content, reason)
+}
+
+// UploadedImage is an image uploaded to a subreddit's stylesheet.
+type UploadedImage struct {
+	// The URL of the uploaded image.
+	URL string `json:"img_src"`
+}
+
+// stylesheetImageNameRegex is the format Reddit requires for the names of stylesheet images.
+var stylesheetImageNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,20}$`)
+
+// UploadImage uploads a PNG or JPEG image to the subreddit's stylesheet, under the given name.
+// The name must be 1 to 20 letters, numbers, underscores or hyphens. It can then be referenced
+// in the stylesheet with url(%%name%%). Uploading an image with an existing name replaces it.
+func (s *SubredditService) UploadImage(ctx context.Context, subreddit string, name string, r io.Reader) (*UploadedImage, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+	if !stylesheetImageNameRegex.MatchString(name) {
+		return nil, nil, fmt.Errorf("name: must be 1 to 20 letters, numbers, underscores or hyphens, got %q", name)
+	}
+	if r == nil {
+		return nil, nil, errors.New("r: cannot be nil")
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var imgType string
+	switch mimetype := http.DetectContentType(data); mimetype {
+	case "image/png":
+		imgType = "png"
+	case "image/jpeg":
+		imgType = "jpg"
+	default:
+		return nil, nil, fmt.Errorf("image: must be a PNG or JPEG, got %s", mimetype)
+	}
+
+	path := fmt.Sprintf("r/%s/api/upload_sr_img", subreddit)
+
+	fields := url.Values{}
+	fields.Set("name", name)
+	fields.Set("img_type", imgType)
+	fields.Set("upload_type", "img")
+
+	req, err := s.client.newRequestWithMultipart(http.MethodPost, path, fields, "file", name+"."+imgType, bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		UploadedImage
+		Errors []string `json:"errors"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(root.Errors) > 0 {
+		return nil, resp, fmt.Errorf("could not upload image: %s", strings.Join(root.Errors, ", "))
+	}
+
+	return &root.UploadedImage, resp, nil
+}
+
+// GetModNotes returns the notes left by moderators about the user in the subreddit, newest first.
+// This requires the user to be a moderator of the subreddit.
+// Use the Before option with the ID of the last note to get the next page.
+func (s *SubredditService) GetModNotes(ctx context.Context, subreddit, username string, opts *ListOptions) ([]*ModNote, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+	if username == "" {
+		return nil, nil, errors.New("username: cannot be empty")
+	}
+
+	path, err := addOptions("api/mod/notes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type params struct {
+		Subreddit string `url:"subreddit"`
+		Username  string `url:"user"`
+	}
+
+	path, err = addOptions(path, params{subreddit, username})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Notes []*ModNote `json:"mod_notes"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Notes, resp, nil
+}
+
+// AddModNote adds a note about a user of the subreddit.
+// This requires the user to be a moderator of the subreddit.
+func (s *SubredditService) AddModNote(ctx context.Context, addRequest *AddModNoteRequest) (*ModNote, *Response, error) {
+	if addRequest == nil {
+		return nil, nil, errors.New("addRequest: cannot be nil")
+	}
+	if err := addRequest.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	form, err := encodeForm(addRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, "api/mod/notes", form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Note *ModNote `json:"created"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Note, resp, nil
+}
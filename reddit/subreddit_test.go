@@ -1,9 +1,12 @@
 package reddit
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,17 +19,18 @@ var expectedPosts = &Posts{
 			ID:      "agi5zf",
 			FullID:  "t3_agi5zf",
 			Created: &Timestamp{time.Date(2019, 1, 16, 5, 57, 51, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/test/comments/agi5zf/test/",
 			URL:       "https://www.reddit.com/r/test/comments/agi5zf/test/",
+			Thumbnail: "self",
 
 			Title: "test",
 			Body:  "test",
 
-			Score:            253,
-			UpvoteRatio:      0.99,
-			NumberOfComments: 1634,
+			Score:              253,
+			UpvoteRatio:        0.99,
+			NumberOfComments:   1634,
+			NumberOfCrossposts: 7,
 
 			SubredditName:         "test",
 			SubredditNamePrefixed: "r/test",
@@ -35,6 +39,8 @@ var expectedPosts = &Posts{
 			Author:   "kmiller0112",
 			AuthorID: "t2_30a5ktgt",
 
+			WhitelistStatus: "all_ads",
+
 			IsSelfPost: true,
 			Stickied:   true,
 		},
@@ -42,10 +48,26 @@ var expectedPosts = &Posts{
 			ID:      "hyhquk",
 			FullID:  "t3_hyhquk",
 			Created: &Timestamp{time.Date(2020, 7, 27, 0, 5, 10, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/test/comments/hyhquk/veggies/",
 			URL:       "https://i.imgur.com/LrN2mPw.jpg",
+			Thumbnail: "https://b.thumbs.redditmedia.com/rg4Aa--ZrHz2PNrmZbBk1cxajQrkRv2cvx2uhp7SSFo.jpg",
+
+			Preview: &Preview{
+				Images: []*PreviewImage{
+					{
+						ID:     "bxde3rpzP-mqawZJwpBIzEiH1y9nOLW3n1ghq9FPAR8",
+						Source: &ImageSource{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?auto=webp&amp;s=f5103946eee4586cba8a1ba410e3098e9a14bb58", Width: 720, Height: 859},
+						Resolutions: []*ImageSource{
+							{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=108&amp;crop=smart&amp;auto=webp&amp;s=a6904af790568dcea8fd3566e5d469df88a3891d", Width: 108, Height: 128},
+							{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=216&amp;crop=smart&amp;auto=webp&amp;s=09720b85b3b469b37030db3e3a5ab7fa231480f9", Width: 216, Height: 257},
+							{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=320&amp;crop=smart&amp;auto=webp&amp;s=78ace2e1c15e0e82dcfc95574d3ea3756812fd98", Width: 320, Height: 381},
+							{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=640&amp;crop=smart&amp;auto=webp&amp;s=d5d5305e3d97553176170ead8462cc0d155a7793", Width: 640, Height: 763},
+						},
+					},
+				},
+				Enabled: true,
+			},
 
 			Title: "Veggies",
 
@@ -59,6 +81,8 @@ var expectedPosts = &Posts{
 
 			Author:   "MuckleMcDuckle",
 			AuthorID: "t2_6fqntbwq",
+
+			WhitelistStatus: "all_ads",
 		},
 	},
 	After:  "t3_hyhquk",
@@ -82,6 +106,13 @@ var expectedSubreddit = &Subreddit{
 	NSFW:            false,
 	UserIsMod:       false,
 	Subscribed:      true,
+
+	CommunityIconURL:      "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_wy4riduoe9k11.png?width=256&amp;s=0d681daaa8d4b6271e6be788d0f9379f0661e04a",
+	BannerBackgroundImage: "https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_k15p9ugyd9k11.png?width=4000&amp;s=dc19f23446f14c3dee0ab59c538fd5dfb243eeb9",
+	FreeFormReports:       true,
+	AllowVideos:           true,
+	AllowImages:           true,
+	ShowMediaPreview:      true,
 }
 
 var expectedSubreddits = &Subreddits{
@@ -104,6 +135,11 @@ var expectedSubreddits = &Subreddits{
 			UserIsMod:   false,
 			Subscribed:  true,
 			Favorite:    false,
+
+			FreeFormReports:  true,
+			AllowVideos:      true,
+			AllowImages:      true,
+			ShowMediaPreview: true,
 		},
 		{
 			ID:      "2qh1i",
@@ -122,6 +158,16 @@ var expectedSubreddits = &Subreddits{
 			UserIsMod:   false,
 			Subscribed:  true,
 			Favorite:    true,
+
+			IconURL:               "https://b.thumbs.redditmedia.com/EndDxMGB-FTZ2MGtjepQ06cQEkZw_YQAsOUudpb9nSQ.png",
+			CommunityIconURL:      "https://styles.redditmedia.com/t5_2qh1i/styles/communityIcon_tijjpyw1qe201.png?width=256&amp;s=4e76eadc662b8155a93d4d7487a6d3acb35f4334",
+			BannerBackgroundColor: "#f0f7fd",
+			KeyColor:              "#222222",
+			PrimaryColor:          "#646d73",
+			SubmitTextLabel:       "Ask a question",
+			WikiEnabled:           true,
+			FreeFormReports:       true,
+			ShowMediaPreview:      true,
 		},
 		{
 			ID:      "2qh0u",
@@ -140,6 +186,16 @@ var expectedSubreddits = &Subreddits{
 			UserIsMod:   false,
 			Subscribed:  false,
 			Favorite:    false,
+
+			IconURL:               "https://b.thumbs.redditmedia.com/VZX_KQLnI1DPhlEZ07bIcLzwR1Win808RIt7zm49VIQ.png",
+			BannerBackgroundColor: "#5a74cc",
+			KeyColor:              "#222222",
+			PrimaryColor:          "#cee3f8",
+			SubmitLinkLabel:       "Submit an image",
+			WikiEnabled:           true,
+			FreeFormReports:       true,
+			AllowImages:           true,
+			ShowMediaPreview:      true,
 		},
 	},
 }
@@ -160,16 +216,42 @@ var expectedSearchPosts = &Posts{
 			ID:      "hybow9",
 			FullID:  "t3_hybow9",
 			Created: &Timestamp{time.Date(2020, 7, 26, 18, 14, 24, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/WatchPeopleDieInside/comments/hybow9/pregnancy_test/",
 			URL:       "https://v.redd.it/ra4qnt8bt8d51",
+			Thumbnail: "https://a.thumbs.redditmedia.com/mTY7zZSrlStun4i_rAehBJN556LUwky1PUbIQhrVvC8.jpg",
+
+			IsVideo: true,
+			Preview: &Preview{
+				Images: []*PreviewImage{
+					{
+						ID:     "6MEEtWN_cm1lRDpu_daXxHcau23YIWh0FeiB96IPgJs",
+						Source: &ImageSource{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?format=pjpg&amp;auto=webp&amp;s=dbe1004d6df4fb6014d78e0c0d817c1106f1f3b2", Width: 360, Height: 360},
+						Resolutions: []*ImageSource{
+							{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=108&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=3de4a7249f291b848838f865bb592f7e51555e96", Width: 108, Height: 108},
+							{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=216&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=531916387899ed20e33386081b5d5c58a73be188", Width: 216, Height: 216},
+							{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=320&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=4d19996fba95dae7fb615cdc102d34c8bfb44e0a", Width: 320, Height: 320},
+						},
+					},
+				},
+			},
+			Media: &Media{
+				RedditVideo: &RedditVideo{
+					FallbackURL: "https://v.redd.it/ra4qnt8bt8d51/DASH_360.mp4?source=fallback",
+					DASHURL:     "https://v.redd.it/ra4qnt8bt8d51/DASHPlaylist.mpd?a=1598576219%2CZjZhYTZlMTYxOTU2MjQzNTBlMmZmMjRiNDRlNDYxM2NjNjZiZjM2NzQxYTA5MTdhMGQyODBmNGJiYjYyOGFjMw%3D%3D&amp;v=1&amp;f=sd",
+					HLSURL:      "https://v.redd.it/ra4qnt8bt8d51/HLSPlaylist.m3u8?a=1598576219%2CNTlmNTJhZDAyMTY4ZDAzNmM1NzAxMTYxZTNmYTk1OTJkYzI3MWEyYjNmNDdmYWU2MWY5ZjUwMzFkODA2YWY1ZQ%3D%3D&amp;v=1&amp;f=sd",
+					Width:       360,
+					Height:      360,
+					Duration:    230,
+				},
+			},
 
 			Title: "Pregnancy test",
 
-			Score:            103829,
-			UpvoteRatio:      0.88,
-			NumberOfComments: 3748,
+			Score:              103829,
+			UpvoteRatio:        0.88,
+			NumberOfComments:   3748,
+			NumberOfCrossposts: 20,
 
 			SubredditName:         "WatchPeopleDieInside",
 			SubredditNamePrefixed: "r/WatchPeopleDieInside",
@@ -177,21 +259,58 @@ var expectedSearchPosts = &Posts{
 
 			Author:   "chocolat_ice_cream",
 			AuthorID: "t2_3p32m02",
+
+			WhitelistStatus: "all_ads",
+
+			Gildings: &Gildings{GildsSilver: 1, GildsGold: 4, GildsPlatinum: 2},
+			Awards: []*Award{
+				{Name: "Bravo Grande!", Description: "For an especially amazing showing.", IconURL: "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png", CoinPrice: 75, Count: 1},
+				{Name: "Narwhal Salute", Description: "A golden splash of respect", IconURL: "https://i.redd.it/award_images/t5_22cerq/80j20o397jj41_NarwhalSalute.png", CoinPrice: 30, Count: 1},
+				{Name: "All-Seeing Upvote", Description: "A glowing commendation for all to see", IconURL: "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png", CoinPrice: 30, Count: 2},
+				{Name: "Platinum", Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.", IconURL: "https://www.redditstatic.com/gold/awards/icon/platinum_512.png", CoinPrice: 1800, Count: 2},
+				{Name: "Gold", Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.", IconURL: "https://www.redditstatic.com/gold/awards/icon/gold_512.png", CoinPrice: 500, Count: 4},
+				{Name: "I'm Deceased", Description: "Call an ambulance, I'm laughing too hard.", IconURL: "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png", CoinPrice: 200, Count: 3},
+				{Name: "Press F", Description: "To pay respects.", IconURL: "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png", CoinPrice: 150, Count: 1},
+				{Name: "Bless Up", Description: "Prayers up for the blessed.", IconURL: "https://i.redd.it/award_images/t5_22cerq/trfv6ems1md41_BlessUp.png", CoinPrice: 150, Count: 1},
+				{Name: "Silver", Description: "Shows the Silver Award... and that's it.", IconURL: "https://www.redditstatic.com/gold/awards/icon/silver_512.png", CoinPrice: 100, Count: 1},
+				{Name: "Faith In Humanity Restored", Description: "When goodness lifts you", IconURL: "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png", CoinPrice: 70, Count: 1},
+				{Name: "Take My Energy", Description: "I'm in this with you.", IconURL: "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png", CoinPrice: 50, Count: 5},
+				{Name: "Ally", Description: "Listen, get educated, and get involved.", IconURL: "https://i.redd.it/award_images/t5_22cerq/5nswjpyy44551_Ally.png", CoinPrice: 50, Count: 1},
+			},
+			TotalAwards: 23,
 		},
 		{
 			ID:      "hmwhd7",
 			FullID:  "t3_hmwhd7",
 			Created: &Timestamp{time.Date(2020, 7, 7, 15, 19, 42, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			Permalink: "/r/worldnews/comments/hmwhd7/brazilian_president_jair_bolsonaro_tests_positive/",
 			URL:       "https://www.theguardian.com/world/2020/jul/07/jair-bolsonaro-coronavirus-positive-test-brazil-president",
+			Thumbnail: "default",
+
+			Preview: &Preview{
+				Images: []*PreviewImage{
+					{
+						ID:     "Ug52cYq0iihKhNVnhJnu_b8ThcVTp27Yjit2korgoUo",
+						Source: &ImageSource{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?auto=webp&amp;s=bcb266e3d2f9b1b8410b8ebc1ba112461ac7c89b", Width: 1200, Height: 630},
+						Resolutions: []*ImageSource{
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=108&amp;crop=smart&amp;auto=webp&amp;s=8cd17cff83d56ad74566088b46a5f656c4e6233b", Width: 108, Height: 56},
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=216&amp;crop=smart&amp;auto=webp&amp;s=279340e68ef64a890709218d27e805e40ef2d1d5", Width: 216, Height: 113},
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=320&amp;crop=smart&amp;auto=webp&amp;s=a57f95db845046e7d75af256fed8a2fab65dec60", Width: 320, Height: 168},
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=640&amp;crop=smart&amp;auto=webp&amp;s=6fc8a7055610d03faaa3b0f32ba521a99b5c2bdd", Width: 640, Height: 336},
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=960&amp;crop=smart&amp;auto=webp&amp;s=be77436ac80c45b2153de325008085920d8d8489", Width: 960, Height: 504},
+							{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=1080&amp;crop=smart&amp;auto=webp&amp;s=71644306bcb0036f2d8ee5bf878e3c78f6c3012c", Width: 1080, Height: 567},
+						},
+					},
+				},
+			},
 
 			Title: "Brazilian president Jair Bolsonaro tests positive for coronavirus",
 
-			Score:            149238,
-			UpvoteRatio:      0.94,
-			NumberOfComments: 7415,
+			Score:              149238,
+			UpvoteRatio:        0.94,
+			NumberOfComments:   7415,
+			NumberOfCrossposts: 22,
 
 			SubredditName:         "worldnews",
 			SubredditNamePrefixed: "r/worldnews",
@@ -199,6 +318,37 @@ var expectedSearchPosts = &Posts{
 
 			Author:   "Jeremy_Martin",
 			AuthorID: "t2_wgrkg",
+
+			WhitelistStatus: "all_ads",
+
+			Gildings: &Gildings{GildsSilver: 2, GildsGold: 3, GildsPlatinum: 1},
+			Awards: []*Award{
+				{Name: "Fireworks", Description: "Bonfires and illuminations are still going strong. Happy 4th of July!", IconURL: "https://www.redditstatic.com/gold/awards/icon/Fireworks_512.png", CoinPrice: 100, Count: 1},
+				{Name: "Take My Power", Description: "Add my power to yours.", IconURL: "https://www.redditstatic.com/gold/awards/icon/TakeMyPower_512.png", CoinPrice: 75, Count: 2},
+				{Name: "Bravo Grande!", Description: "For an especially amazing showing.", IconURL: "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png", CoinPrice: 75, Count: 1},
+				{Name: "Wholesome Seal of Approval", Description: "A glittering stamp for a feel-good thing", IconURL: "https://i.redd.it/award_images/t5_22cerq/b9ks3a5k7jj41_WholesomeSealofApproval.png", CoinPrice: 30, Count: 1},
+				{Name: "All-Seeing Upvote", Description: "A glowing commendation for all to see", IconURL: "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png", CoinPrice: 30, Count: 2},
+				{Name: "Yas Queen", Description: "YAAAAAAAAAAASSS.", IconURL: "https://i.redd.it/award_images/t5_22cerq/kthj3e4h3bm41_YasQueen.png", CoinPrice: 250, Count: 2},
+				{Name: "Platinum", Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.", IconURL: "https://www.redditstatic.com/gold/awards/icon/platinum_512.png", CoinPrice: 1800, Count: 1},
+				{Name: "Gold", Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.", IconURL: "https://www.redditstatic.com/gold/awards/icon/gold_512.png", CoinPrice: 500, Count: 3},
+				{Name: "Bless Up (Pro)", Description: "Prayers up for the blessed. Gives %{coin_symbol}100 Coins to both the author and the community.", IconURL: "https://i.redd.it/award_images/t5_22cerq/xe5mw55w5v541_BlessUp.png", CoinPrice: 500, Count: 1},
+				{Name: "Doot 🎵 Doot", Description: "Sometimes you just got to dance with the doots.", IconURL: "https://www.redditstatic.com/gold/awards/icon/Updoot_512.png", CoinPrice: 400, Count: 6},
+				{Name: "Updoot", Description: "Sometimes you just got to doot.", IconURL: "https://i.redd.it/award_images/t5_22cerq/7atjjqpy1mc41_Updoot.png", CoinPrice: 300, Count: 1},
+				{Name: "Stonks Rising", Description: "To the MOON.", IconURL: "https://i.redd.it/award_images/t5_22cerq/s5edqq9abef41_StonksRising.png", CoinPrice: 200, Count: 2},
+				{Name: "I'm Deceased", Description: "Call an ambulance, I'm laughing too hard.", IconURL: "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png", CoinPrice: 200, Count: 7},
+				{Name: "Press F", Description: "To pay respects.", IconURL: "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png", CoinPrice: 150, Count: 4},
+				{Name: "Wholesome", Description: "When you come across a feel-good thing.", IconURL: "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png", CoinPrice: 125, Count: 5},
+				{Name: "Silver", Description: "Shows the Silver Award... and that's it.", IconURL: "https://www.redditstatic.com/gold/awards/icon/silver_512.png", CoinPrice: 100, Count: 2},
+				{Name: "Snek", Description: "A smol, delicate danger noodle.", IconURL: "https://i.redd.it/award_images/t5_22cerq/rc5iesz2z8t41_Snek.png", CoinPrice: 70, Count: 1},
+				{Name: "Faith In Humanity Restored", Description: "When goodness lifts you", IconURL: "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png", CoinPrice: 70, Count: 2},
+				{Name: "Facepalm", Description: "*Lowers face into palm*", IconURL: "https://i.redd.it/award_images/t5_22cerq/ey2iodron2s41_Facepalm.png", CoinPrice: 70, Count: 3},
+				{Name: "Take My Energy", Description: "I'm in this with you.", IconURL: "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png", CoinPrice: 50, Count: 2},
+				{Name: "Nothing To Do", Description: "I've got nothing to do, and I'm trying to do nothing.", IconURL: "https://i.redd.it/award_images/t5_22cerq/1snr345pm1w41_NothingToDo.png", CoinPrice: 50, Count: 1},
+				{Name: "Safe &amp; Social", Description: "Connecting together responsibly", IconURL: "https://i.redd.it/award_images/t5_22cerq/qq73pijkm3p41_SafeSocial.png", CoinPrice: 30, Count: 1},
+				{Name: "Home Time", Description: "Staying home &amp; being safe when you can", IconURL: "https://i.redd.it/award_images/t5_22cerq/qh4pzo76v9p41_HomeTime.png", CoinPrice: 30, Count: 2},
+				{Name: "Healthcare Hero", Description: "Putting yourself on the line for us - you are the perfect super hero!", IconURL: "https://i.redd.it/award_images/t5_22cerq/xs2na1t1v9p41_HealthcareHero.png", CoinPrice: 30, Count: 7},
+			},
+			TotalAwards: 60,
 		},
 	},
 	After: "t3_hmwhd7",
@@ -216,6 +366,10 @@ var expectedRandomSubreddit = &Subreddit{
 	Type:         "public",
 
 	Subscribers: 52357,
+
+	IconURL:         "https://b.thumbs.redditmedia.com/4hg41g2_X1R5S_HTUscWCK_7iAo6SPdag_oOlSx7WAM.png",
+	PrimaryColor:    "#373c3f",
+	FreeFormReports: true,
 }
 
 var expectedRelationships3 = &Relationships{
@@ -323,6 +477,33 @@ func TestSubredditService_NewPosts(t *testing.T) {
 	require.Equal(t, expectedPosts, posts)
 }
 
+func TestSubredditService_NewPosts_IncludeSubredditDetail(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/random.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/GalaxyS8/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "1")
+		form.Set("sr_detail", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Subreddit.NewPosts(ctx, "GalaxyS8", &ListOptions{Limit: 1, IncludeSubredditDetail: true})
+	require.NoError(t, err)
+	require.NotEmpty(t, posts.Posts)
+	require.Equal(t, expectedRandomSubreddit, posts.Posts[0].Subreddit)
+}
+
 func TestSubredditService_RisingPosts(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -394,6 +575,98 @@ func TestSubredditService_Get(t *testing.T) {
 	require.Equal(t, expectedSubreddit, subreddit)
 }
 
+func TestSubredditService_Get_UnknownType(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/about.json")
+	require.NoError(t, err)
+	blob = strings.Replace(blob, `"subreddit_type": "public"`, `"subreddit_type": "gold_restricted_archived"`, 1)
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	// values Reddit adds in the future must be kept as is, without affecting the other fields
+	expected := *expectedSubreddit
+	expected.Type = "gold_restricted_archived"
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, &expected, subreddit)
+}
+
+func TestSubredditService_GetRelated(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/related.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/related", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+	mux.HandleFunc("/r/doesnotexist/related", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err = client.Subreddit.GetRelated(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Subreddit.GetRelated(ctx, "doesnotexist")
+	require.ErrorIs(t, err, ErrSubredditNotFound)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	subreddits, _, err := client.Subreddit.GetRelated(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, []*Subreddit{
+		{
+			ID:      "2rc7j",
+			FullID:  "t5_2rc7j",
+			Created: &Timestamp{time.Date(2009, 11, 3, 0, 54, 24, 0, time.UTC)},
+
+			URL:          "/r/golang/",
+			Name:         "golang",
+			NamePrefixed: "r/golang",
+			Title:        "The Go Programming Language",
+			Description:  "Ask questions and post articles about the Go programming language and related tools, events etc.",
+			Type:         "public",
+
+			Subscribers: 235716,
+		},
+		{
+			ID:      "2qh1i",
+			FullID:  "t5_2qh1i",
+			Created: &Timestamp{time.Date(2006, 2, 28, 18, 19, 29, 0, time.UTC)},
+
+			URL:          "/r/programming/",
+			Name:         "programming",
+			NamePrefixed: "r/programming",
+			Title:        "programming",
+			Description:  "Computer Programming",
+			Type:         "public",
+
+			Subscribers: 3752145,
+		},
+		{
+			ID:      "2s5ti",
+			FullID:  "t5_2s5ti",
+			Created: &Timestamp{time.Date(2010, 12, 3, 1, 21, 10, 0, time.UTC)},
+
+			URL:          "/r/rust/",
+			Name:         "rust",
+			NamePrefixed: "r/rust",
+			Title:        "The Rust Programming Language",
+			Description:  "A place for all things related to the Rust programming language.",
+			Type:         "public",
+
+			Subscribers: 187342,
+		},
+	}, subreddits)
+}
+
 func TestSubredditService_Popular(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -513,6 +786,114 @@ func TestSubredditService_Moderated(t *testing.T) {
 	require.Equal(t, expectedSubreddits, subreddits)
 }
 
+// handleSubredditPages serves 6 subreddits over 2 pages at the given path.
+func handleSubredditPages(t *testing.T, mux *http.ServeMux, path string) {
+	pages := map[string]string{
+		"":     `{"kind": "Listing", "data": {"after": "t5_3", "children": [{"kind": "t5", "data": {"id": "1", "name": "t5_1"}}, {"kind": "t5", "data": {"id": "2", "name": "t5_2"}}, {"kind": "t5", "data": {"id": "3", "name": "t5_3"}}]}}`,
+		"t5_3": `{"kind": "Listing", "data": {"after": null, "children": [{"kind": "t5", "data": {"id": "4", "name": "t5_4"}}, {"kind": "t5", "data": {"id": "5", "name": "t5_5"}}, {"kind": "t5", "data": {"id": "6", "name": "t5_6"}}]}}`,
+	}
+
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "100", r.Form.Get("limit"))
+
+		after := r.Form.Get("after")
+		page, ok := pages[after]
+		require.True(t, ok)
+
+		w.Header().Set("X-Page-After", after)
+		fmt.Fprint(w, page)
+	})
+}
+
+func requireAllSubreddits(t *testing.T, subreddits []*Subreddit, resp *Response, err error) {
+	require.NoError(t, err)
+	require.Len(t, subreddits, 6)
+	for i, subreddit := range subreddits {
+		require.Equal(t, fmt.Sprint(i+1), subreddit.ID)
+	}
+	require.Equal(t, "t5_3", resp.Header.Get("X-Page-After"))
+}
+
+func TestSubredditService_AllSubscribed(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handleSubredditPages(t, mux, "/subreddits/mine/subscriber")
+
+	subreddits, resp, err := client.Subreddit.AllSubscribed(ctx)
+	requireAllSubreddits(t, subreddits, resp, err)
+}
+
+func TestSubredditService_AllApproved(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handleSubredditPages(t, mux, "/subreddits/mine/contributor")
+
+	subreddits, resp, err := client.Subreddit.AllApproved(ctx)
+	requireAllSubreddits(t, subreddits, resp, err)
+}
+
+func TestSubredditService_AllModerated(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handleSubredditPages(t, mux, "/subreddits/mine/moderator")
+
+	subreddits, resp, err := client.Subreddit.AllModerated(ctx)
+	requireAllSubreddits(t, subreddits, resp, err)
+}
+
+func TestSubredditService_AllSubscribed_ContextDone(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, _, err := client.Subreddit.AllSubscribed(cancelledCtx)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestSubredditService_GetSticky(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	var num string
+	mux.HandleFunc("/r/test/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, num, r.Form.Get("num"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.GetSticky(ctx, "test", 0)
+	require.EqualError(t, err, "position: must be 1 or 2, got 0")
+
+	_, _, err = client.Subreddit.GetSticky(ctx, "test", 3)
+	require.EqualError(t, err, "position: must be 1 or 2, got 3")
+
+	num = "1"
+	postAndComments, _, err := client.Subreddit.GetSticky(ctx, "test", 1)
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+
+	num = "2"
+	postAndComments, _, err = client.Subreddit.GetSticky(ctx, "test", 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+}
+
 func TestSubredditService_GetSticky1(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -561,19 +942,30 @@ func TestSubredditService_Subscribe(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
 
+	var skipInitialDefaults bool
 	mux.HandleFunc("/api/subscribe", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
 
 		form := url.Values{}
 		form.Set("action", "sub")
 		form.Set("sr_name", "test,golang,nba")
+		if skipInitialDefaults {
+			form.Set("skip_initial_defaults", "true")
+		}
 
 		err := r.ParseForm()
 		require.NoError(t, err)
 		require.Equal(t, form, r.Form)
 	})
 
-	_, err := client.Subreddit.Subscribe(ctx, "test", "golang", "nba")
+	_, err := client.Subreddit.Subscribe(ctx, nil, "test", "golang", "nba")
+	require.NoError(t, err)
+
+	_, err = client.Subreddit.Subscribe(ctx, &SubscribeOptions{}, "test", "golang", "nba")
+	require.NoError(t, err)
+
+	skipInitialDefaults = true
+	_, err = client.Subreddit.Subscribe(ctx, &SubscribeOptions{SkipInitialDefaults: true}, "test", "golang", "nba")
 	require.NoError(t, err)
 }
 
@@ -705,7 +1097,7 @@ func TestSubredditService_Search(t *testing.T) {
 		ListOptions: ListOptions{
 			Limit: 10,
 		},
-		Sort: "activity",
+		Sort: SortActivity,
 	})
 	require.NoError(t, err)
 	require.Equal(t, expectedSubreddits, subreddits)
@@ -736,6 +1128,89 @@ func TestSubredditService_SearchNames(t *testing.T) {
 	require.Equal(t, expectedSubredditNames, names)
 }
 
+func TestSubredditService_Autocomplete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/autocomplete.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/subreddit_autocomplete_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("query", "golang")
+		form.Set("include_profiles", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.Autocomplete(ctx, "", true)
+	require.ErrorIs(t, err, ErrEmptyQuery)
+
+	subreddits, _, err := client.Subreddit.Autocomplete(ctx, "golang", true)
+	require.NoError(t, err)
+	require.Equal(t, []*Subreddit{
+		{
+			ID:           "2rc7j",
+			FullID:       "t5_2rc7j",
+			URL:          "/r/golang/",
+			Name:         "golang",
+			NamePrefixed: "r/golang",
+			Type:         "public",
+
+			CommunityIconURL: "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_wy4riduoe9k11.png?width=256&amp;s=0d681daaa8d4b6271e6be788d0f9379f0661e04a",
+			PrimaryColor:     "#373c3f",
+
+			Subscribers: 139064,
+			AllowImages: true,
+		},
+		{
+			ID:           "3kefx",
+			FullID:       "t5_3kefx",
+			URL:          "/user/golang_user/",
+			Name:         "u_golang_user",
+			NamePrefixed: "u/golang_user",
+			Type:         "user",
+
+			IconURL: "https://www.redditstatic.com/avatars/avatar_default_16_25B79F.png",
+
+			Subscribers: 12,
+			AllowImages: true,
+			AllowVideos: true,
+		},
+	}, subreddits)
+}
+
+func TestSubredditService_Recommended(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/recommend/sr/golang,rust", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("omit", "programming,python")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `[{"sr_name": "learngolang"}, {"sr_name": "cpp"}]`)
+	})
+
+	_, _, err := client.Subreddit.Recommended(ctx, nil, nil)
+	require.EqualError(t, err, "must provide at least 1 subreddit")
+
+	names, _, err := client.Subreddit.Recommended(ctx, []string{"golang", "rust"}, []string{"programming", "python"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"learngolang", "cpp"}, names)
+}
+
 func TestSubredditService_SearchPosts(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -761,6 +1236,68 @@ func TestSubredditService_SearchPosts(t *testing.T) {
 	require.Equal(t, expectedSearchPosts, posts)
 }
 
+func TestSubredditService_SearchPosts_CreatedRange(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/all/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "test")
+		form.Set("after", "1577836800")
+		form.Set("before", "1580515200")
+		form.Set("include_over_18", "false")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	after := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	posts, _, err := client.Subreddit.SearchPosts(ctx, "test", "", &ListPostSearchOptions{
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+		IncludeNSFW:   Bool(false),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSearchPosts, posts)
+}
+
+func TestListPostSearchOptions_Validate(t *testing.T) {
+	after := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Now().Add(time.Hour)
+
+	require.NoError(t, (&ListPostSearchOptions{}).Validate())
+	require.NoError(t, (&ListPostSearchOptions{CreatedAfter: &after, CreatedBefore: &before}).Validate())
+
+	err := (&ListPostSearchOptions{CreatedBefore: &future}).Validate()
+	require.EqualError(t, err, "CreatedBefore: cannot be in the future")
+
+	err = (&ListPostSearchOptions{CreatedAfter: &before, CreatedBefore: &after}).Validate()
+	require.EqualError(t, err, "CreatedBefore: must be after CreatedAfter")
+
+	opts := &ListPostSearchOptions{CreatedAfter: &after}
+	opts.After = "t3_test"
+	require.EqualError(t, opts.Validate(), "CreatedAfter: cannot be used with After")
+
+	opts = &ListPostSearchOptions{CreatedBefore: &before}
+	opts.Before = "t3_test"
+	require.EqualError(t, opts.Validate(), "CreatedBefore: cannot be used with Before")
+
+	opts = &ListPostSearchOptions{}
+	opts.Limit = 101
+	require.Error(t, opts.Validate())
+}
+
 func TestSubredditService_SearchPosts_InSubreddit(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -874,6 +1411,89 @@ func TestSubredditService_SubmissionText(t *testing.T) {
 	text, _, err := client.Subreddit.SubmissionText(ctx, "test")
 	require.NoError(t, err)
 	require.Equal(t, "this is a test", text)
+
+	_, _, err = client.Subreddit.SubmissionText(ctx, "")
+	require.EqualError(t, err, "name: cannot be empty")
+}
+
+func TestSubredditService_SubmissionTextWithHTML(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/test/api/submit_text", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{
+			"submit_text": "this is a **test**",
+			"submit_text_html": "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;this is a &lt;strong&gt;test&lt;/strong&gt;&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;"
+		}`)
+	})
+
+	text, _, err := client.Subreddit.SubmissionTextWithHTML(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, &SubmissionText{
+		Markdown: "this is a **test**",
+		HTML:     "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;this is a &lt;strong&gt;test&lt;/strong&gt;&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+	}, text)
+}
+
+func TestSubredditService_GetAboutRules(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/rules.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/about/rules", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.GetAboutRules(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	rules, _, err := client.Subreddit.GetAboutRules(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, &SubredditRules{
+		Rules: []*Rule{
+			{
+				ShortName:       "Must be about Go",
+				Description:     "Posts must be related to the Go programming language.",
+				DescriptionHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;Posts must be related to the Go programming language.&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+				Kind:            "link",
+				ViolationReason: "Not about Go",
+				CreatedAt:       &Timestamp{time.Date(2020, 4, 30, 21, 20, 0, 0, time.UTC)},
+				Priority:        0,
+			},
+			{
+				ShortName:       "Be civil",
+				Description:     "Be civil.",
+				DescriptionHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;Be civil.&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+				Kind:            "all",
+				ViolationReason: "Incivility",
+				CreatedAt:       &Timestamp{time.Date(2020, 5, 1, 21, 20, 0, 0, time.UTC)},
+				Priority:        1,
+			},
+		},
+		SiteRules: []string{"Spam", "Personal and confidential information"},
+		SiteRulesFlow: []*SiteRuleFlow{
+			{ReasonTextToShow: "This is spam", ReasonText: "This is spam"},
+			{
+				ReasonTextToShow: "This is abusive or harassing",
+				NextStepHeader:   "In what way?",
+				NextStepReasons: []*SiteRuleFlow{
+					{ReasonTextToShow: "It's targeted harassment at me", ReasonText: "It's targeted harassment at me"},
+				},
+			},
+			{
+				ReasonTextToShow:    "It infringes my copyright",
+				ReasonText:          "It infringes my copyright",
+				FileComplaint:       true,
+				ComplaintPageTitle:  "File a copyright complaint",
+				ComplaintButtonText: "File a complaint",
+				ComplaintURL:        "https://www.reddit.com/api/report_redirect?thing=%25%28thing%29s&reason_code=COPYRIGHT",
+			},
+		},
+	}, rules)
 }
 
 func TestSubredditService_Banned(t *testing.T) {
@@ -1020,3 +1640,468 @@ func TestSubredditService_Moderators(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedModerators, moderators)
 }
+
+func TestSubredditService_Stylesheet(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/stylesheet.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.Stylesheet(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	stylesheet, _, err := client.Subreddit.Stylesheet(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, &Stylesheet{
+		SubredditID: "t5_test",
+		Images: []*StylesheetImage{
+			{
+				Name: "snoo",
+				Link: "url(%%snoo%%)",
+				URL:  "https://b.thumbs.redditmedia.com/test.png",
+			},
+		},
+		Stylesheet: ".side { background: url(%%snoo%%); }",
+	}, stylesheet)
+}
+
+func TestSubredditService_GetStylesheet(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/stylesheet.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.GetStylesheet(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	css, _, err := client.Subreddit.GetStylesheet(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, ".side { background: url(%%snoo%%); }", css)
+}
+
+func TestSubredditService_UpdateStylesheet(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/subreddit_stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("op", "save")
+		form.Set("stylesheet_contents", ".side { display: none; }")
+		form.Set("reason", "hide the sidebar")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.UpdateStylesheet(ctx, "testsubreddit", strings.Repeat("a", 100001), "")
+	require.ErrorIs(t, err, ErrStylesheetTooLong)
+
+	_, err = client.Subreddit.UpdateStylesheet(ctx, "testsubreddit", ".side { display: none; }", "hide the sidebar")
+	require.NoError(t, err)
+}
+
+func TestSubredditService_Widgets(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/widgets.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/widgets", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.Widgets(ctx, "")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	widgets, _, err := client.Subreddit.Widgets(ctx, "testsubreddit")
+	require.NoError(t, err)
+
+	require.Equal(t, []Widget{
+		&TextAreaWidget{
+			ID:   "widget_13xm3uqagmpp8",
+			Name: "About",
+			Text: "Welcome to the subreddit!",
+		},
+		&RulesWidget{
+			ID:      "widget_rules-2uquw1",
+			Name:    "Rules",
+			Display: "compact",
+			Rules: []*WidgetRule{
+				{Name: "Be civil", Description: "Be nice to each other.", ViolationReason: "Incivility"},
+			},
+		},
+		&ButtonWidget{
+			ID:          "widget_13xm4f7ezpqbk",
+			Name:        "Links",
+			Description: "Useful links",
+			Buttons: []*WidgetButton{
+				{Kind: "text", Text: "Wiki", URL: "https://www.reddit.com/r/test/wiki"},
+			},
+		},
+		&CommunityListWidget{
+			ID:   "widget_13xm5j3zt8kqz",
+			Name: "Related",
+			Communities: []*WidgetCommunity{
+				{Name: "golang", Subscribers: 170000},
+			},
+		},
+		&ImageWidget{
+			ID:   "widget_13xm6b2hz0wbb",
+			Name: "Banner",
+			Images: []*WidgetImage{
+				{URL: "https://www.redditstatic.com/image.png", LinkURL: "https://www.reddit.com", Height: 100, Width: 300},
+			},
+		},
+	}, widgets.Sidebar)
+
+	require.Len(t, widgets.Topbar, 1)
+	menu, ok := widgets.Topbar[0].(*UnknownWidget)
+	require.True(t, ok)
+	require.Equal(t, "widget_13xm7a9xdc1yj", menu.GetID())
+	require.Equal(t, "menu", menu.Kind)
+	require.NotEmpty(t, menu.Raw)
+
+	require.Equal(t, "widget_id-card-2uquw1", widgets.IDCard.GetID())
+	require.Equal(t, "widget_moderators-2uquw1", widgets.Moderators.GetID())
+}
+
+func TestSubredditService_SearchUsers(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/user/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/users/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "washington")
+		form.Set("limit", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.SearchUsers(ctx, "", nil)
+	require.ErrorIs(t, err, ErrEmptyQuery)
+
+	users, _, err := client.Subreddit.SearchUsers(ctx, "washington", &ListOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, "washingtonpost", users[0].Name)
+}
+
+func TestSubredditService_GetWikiPage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/wiki/page.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/wiki/config/sidebar", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	page, _, err := client.Subreddit.GetWikiPage(ctx, "testsubreddit", "config/sidebar")
+	require.NoError(t, err)
+	require.Equal(t, expectedWikiPage, page)
+}
+
+func TestSubredditService_EditWikiPage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/wiki/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("page", "config/sidebar")
+		form.Set("content", "test content")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.EditWikiPage(ctx, "testsubreddit", "config/sidebar", "test content", "")
+	require.NoError(t, err)
+}
+
+func TestSubredditService_GetMultipleByName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		if counter == 0 {
+			require.Len(t, strings.Split(r.Form.Get("sr_name"), ","), 100)
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t5", "data": {"display_name": "golang", "name": "t5_2rc7j"}},
+						{"kind": "t5", "data": {"display_name": "AskReddit", "name": "t5_2qh1i"}}
+					]
+				}
+			}`)
+			return
+		}
+
+		require.Equal(t, "test", r.Form.Get("sr_name"))
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t5", "data": {"display_name": "test", "name": "t5_2qh23"}}
+				]
+			}
+		}`)
+	})
+
+	_, _, err := client.Subreddit.GetMultipleByName(ctx)
+	require.EqualError(t, err, "must provide at least 1 name")
+
+	names := []string{"test", "askreddit", "privatesub", "golang", "GoLang"}
+	// 101 unique names once "GoLang" is deduplicated
+	for i := 0; len(names) < 102; i++ {
+		names = append(names, fmt.Sprintf("missing%d", i))
+	}
+	// put "test" in the second batch
+	names = append(names[1:], names[0])
+
+	subreddits, _, err := client.Subreddit.GetMultipleByName(ctx, names...)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+	require.Len(t, subreddits, 3)
+	require.Equal(t, "AskReddit", subreddits[0].Name)
+	require.Equal(t, "golang", subreddits[1].Name)
+	require.Equal(t, "test", subreddits[2].Name)
+}
+
+func TestSubredditService_UploadImage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/upload_sr_img", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseMultipartForm(1 << 20)
+		require.NoError(t, err)
+
+		require.Equal(t, "snoo", r.FormValue("name"))
+		require.Equal(t, "png", r.FormValue("img_type"))
+		require.Equal(t, "img", r.FormValue("upload_type"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		require.Equal(t, "snoo.png", header.Filename)
+
+		data, err := ioutil.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, testPNG, string(data))
+
+		fmt.Fprint(w, `{"errors": [], "img_src": "https://b.thumbs.redditmedia.com/test.png", "errors_values": []}`)
+	})
+
+	_, _, err := client.Subreddit.UploadImage(ctx, "", "snoo", strings.NewReader(testPNG))
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Subreddit.UploadImage(ctx, "testsubreddit", "snoo image", strings.NewReader(testPNG))
+	require.EqualError(t, err, `name: must be 1 to 20 letters, numbers, underscores or hyphens, got "snoo image"`)
+
+	_, _, err = client.Subreddit.UploadImage(ctx, "testsubreddit", strings.Repeat("a", 21), strings.NewReader(testPNG))
+	require.Error(t, err)
+
+	_, _, err = client.Subreddit.UploadImage(ctx, "testsubreddit", "snoo", strings.NewReader("not an image"))
+	require.EqualError(t, err, "image: must be a PNG or JPEG, got text/plain; charset=utf-8")
+
+	image, _, err := client.Subreddit.UploadImage(ctx, "testsubreddit", "snoo", strings.NewReader(testPNG))
+	require.NoError(t, err)
+	require.Equal(t, &UploadedImage{URL: "https://b.thumbs.redditmedia.com/test.png"}, image)
+}
+
+func TestSubredditService_UploadImage_Error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/upload_sr_img", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errors": ["IMAGE_ERROR"], "img_src": "", "errors_values": ["too big"]}`)
+	})
+
+	_, _, err := client.Subreddit.UploadImage(ctx, "testsubreddit", "snoo", strings.NewReader(testPNG))
+	require.EqualError(t, err, "could not upload image: IMAGE_ERROR")
+}
+
+var expectedModNote = &ModNote{
+	ID:   "ModNote_a1b2c3d4-e5f6-11ec-8f3b-7a1b2c3d4e5f",
+	Type: "NOTE",
+
+	Operator:   "testmod",
+	OperatorID: "t2_testmod",
+
+	Label:    ModNoteLabelSpamWatch,
+	Note:     "Posted the same link in 5 threads",
+	RedditID: "t3_abc123",
+
+	CreatedAt: &Timestamp{time.Date(2022, 5, 17, 0, 0, 0, 0, time.UTC)},
+
+	UserID:      "t2_testuser",
+	Username:    "testuser",
+	SubredditID: "t5_2qh23",
+	Subreddit:   "test",
+}
+
+func TestSubredditService_GetModNotes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/mod-notes.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "test")
+		form.Set("user", "testuser")
+		form.Set("limit", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.GetModNotes(ctx, "", "testuser", nil)
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Subreddit.GetModNotes(ctx, "test", "", nil)
+	require.EqualError(t, err, "username: cannot be empty")
+
+	notes, _, err := client.Subreddit.GetModNotes(ctx, "test", "testuser", &ListOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []*ModNote{
+		expectedModNote,
+		{
+			ID:   "ModNote_f6e5d4c3-b2a1-11ec-9c2d-6a5b4c3d2e1f",
+			Type: "NOTE",
+
+			Operator:   "testmod",
+			OperatorID: "t2_testmod",
+
+			Note: "Always helpful in the weekly thread",
+
+			CreatedAt: &Timestamp{time.Date(2022, 5, 3, 0, 0, 0, 0, time.UTC)},
+
+			UserID:      "t2_testuser",
+			Username:    "testuser",
+			SubredditID: "t5_2qh23",
+			Subreddit:   "test",
+		},
+	}, notes)
+}
+
+func TestSubredditService_AddModNote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/subreddit/add-mod-note.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "test")
+		form.Set("user", "testuser")
+		form.Set("note", "Posted the same link in 5 threads")
+		form.Set("label", "SPAM_WATCH")
+		form.Set("reddit_id", "t3_abc123")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.AddModNote(ctx, nil)
+	require.EqualError(t, err, "addRequest: cannot be nil")
+
+	_, _, err = client.Subreddit.AddModNote(ctx, &AddModNoteRequest{Username: "testuser", Note: "note"})
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Subreddit.AddModNote(ctx, &AddModNoteRequest{Subreddit: "test", Note: "note"})
+	require.EqualError(t, err, "username: cannot be empty")
+
+	_, _, err = client.Subreddit.AddModNote(ctx, &AddModNoteRequest{Subreddit: "test", Username: "testuser"})
+	require.EqualError(t, err, "note: cannot be empty")
+
+	_, _, err = client.Subreddit.AddModNote(ctx, &AddModNoteRequest{Subreddit: "test", Username: "testuser", Note: "note", Label: "SPAM_SUSPECT"})
+	require.EqualError(t, err, `label: unknown mod note label "SPAM_SUSPECT"`)
+
+	_, _, err = client.Subreddit.AddModNote(ctx, &AddModNoteRequest{Subreddit: "test", Username: "testuser", Note: "note", RedditID: "t5_test"})
+	require.ErrorIs(t, err, ErrInvalidFullID)
+
+	note, _, err := client.Subreddit.AddModNote(ctx, &AddModNoteRequest{
+		Subreddit: "test",
+		Username:  "testuser",
+		Note:      "Posted the same link in 5 threads",
+		Label:     ModNoteLabelSpamWatch,
+		RedditID:  "t3_abc123",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModNote, note)
+}
+
+func TestModNoteLabel(t *testing.T) {
+	labels := map[ModNoteLabel]string{
+		ModNoteLabelBotBan:           "BOT_BAN",
+		ModNoteLabelPermaBan:         "PERMA_BAN",
+		ModNoteLabelBan:              "BAN",
+		ModNoteLabelAbuseWarning:     "ABUSE_WARNING",
+		ModNoteLabelSpamWarning:      "SPAM_WARNING",
+		ModNoteLabelSpamWatch:        "SPAM_WATCH",
+		ModNoteLabelSolidContributor: "SOLID_CONTRIBUTOR",
+		ModNoteLabelHelpfulUser:      "HELPFUL_USER",
+	}
+	for label, value := range labels {
+		require.Equal(t, value, string(label))
+		require.True(t, label.IsValid(), value)
+	}
+
+	require.False(t, ModNoteLabel("").IsValid())
+	require.False(t, ModNoteLabel("spam_watch").IsValid())
+}
@@ -2,15 +2,18 @@ package reddit
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 )
 
 const (
-	kindComment    = "t1"
-	kindAccount    = "t2"
-	kindPost       = "t3"
-	kindMessage    = "t4"
-	kindSubreddit  = "t5"
-	kindAward      = "t6"
+	kindComment    = KindComment
+	kindAccount    = KindAccount
+	kindPost       = KindPost
+	kindMessage    = KindMessage
+	kindSubreddit  = KindSubreddit
+	kindAward      = KindAward
 	kindListing    = "Listing"
 	kindKarmaList  = "KarmaList"
 	kindTrophyList = "TrophyList"
@@ -19,6 +22,16 @@ const (
 	kindModAction  = "modaction"
 )
 
+// validateFullID checks that the full ID is prefixed by one of the provided kinds, e.g. t3_abc123.
+func validateFullID(id string, kinds ...string) error {
+	for _, kind := range kinds {
+		if strings.HasPrefix(id, kind+"_") && len(id) > len(kind)+1 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidFullID, id)
+}
+
 // thing is an entity on Reddit.
 // Its kind reprsents what it is and what is stored in the Data field
 // e.g. t1 = comment, t2 = user, t3 = post, etc.
@@ -45,7 +58,9 @@ type things struct {
 	Mores      []*More
 	Users      []*User
 	Posts      []*Post
+	Messages   []*Message
 	Subreddits []*Subreddit
+	Trophies   []*Trophy
 	ModActions []*ModAction
 }
 
@@ -55,7 +70,9 @@ func (t *things) init() {
 	t.Mores = make([]*More, 0)
 	t.Users = make([]*User, 0)
 	t.Posts = make([]*Post, 0)
+	t.Messages = make([]*Message, 0)
 	t.Subreddits = make([]*Subreddit, 0)
+	t.Trophies = make([]*Trophy, 0)
 	t.ModActions = make([]*ModAction, 0)
 }
 
@@ -90,11 +107,21 @@ func (t *things) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal(thing.Data, v); err == nil {
 				t.Posts = append(t.Posts, v)
 			}
+		case kindMessage:
+			v := new(Message)
+			if err := json.Unmarshal(thing.Data, v); err == nil {
+				t.Messages = append(t.Messages, v)
+			}
 		case kindSubreddit:
 			v := new(Subreddit)
 			if err := json.Unmarshal(thing.Data, v); err == nil {
 				t.Subreddits = append(t.Subreddits, v)
 			}
+		case kindAward:
+			v := new(Trophy)
+			if err := json.Unmarshal(thing.Data, v); err == nil {
+				t.Trophies = append(t.Trophies, v)
+			}
 		case kindModAction:
 			v := new(ModAction)
 			if err := json.Unmarshal(thing.Data, v); err == nil {
@@ -111,12 +138,14 @@ type Comment struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	// Nil if it was never edited.
+	Edited *Timestamp `json:"edited,omitempty"`
 
 	ParentID  string `json:"parent_id,omitempty"`
 	Permalink string `json:"permalink,omitempty"`
 
 	Body            string `json:"body,omitempty"`
+	BodyHTML        string `json:"body_html,omitempty"`
 	Author          string `json:"author,omitempty"`
 	AuthorID        string `json:"author_fullname,omitempty"`
 	AuthorFlairText string `json:"author_flair_text,omitempty"`
@@ -132,6 +161,13 @@ type Comment struct {
 
 	Score            int `json:"score"`
 	Controversiality int `json:"controversiality"`
+	// How deeply nested the comment is in its post's comment tree, starting from 0 for top-level comments.
+	// This doesn't appear consistently.
+	Depth int `json:"depth,omitempty"`
+
+	// One of: moderator, admin, special.
+	// Empty if the comment is not distinguished.
+	Distinguished string `json:"distinguished,omitempty"`
 
 	PostID string `json:"link_id,omitempty"`
 	// This doesn't appear consistently.
@@ -151,9 +187,36 @@ type Comment struct {
 	CanGild     bool `json:"can_gild"`
 	NSFW        bool `json:"over_18"`
 
+	// Whether the comment is collapsed by default, e.g. because of its low score.
+	Collapsed bool `json:"collapsed"`
+	// Why the comment is collapsed, if it is.
+	CollapsedReason string `json:"collapsed_reason,omitempty"`
+
+	// Nil if the comment has not been gilded.
+	Gildings *Gildings `json:"gildings,omitempty"`
+	Awards   []*Award  `json:"all_awardings,omitempty"`
+	// The total number of awards received, i.e. the sum of the awards' counts.
+	TotalAwards int `json:"total_awards_received"`
+
 	Replies Replies `json:"replies"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type comment Comment
+	err := json.Unmarshal(data, (*comment)(c))
+	if err != nil {
+		return err
+	}
+
+	c.Gildings, c.Awards = normalizeAwards(c.Gildings, c.Awards)
+	// Reddit sends false for comments that were never edited.
+	if c.Edited != nil && c.Edited.IsZero() {
+		c.Edited = nil
+	}
+	return nil
+}
+
 // HasMore determines whether the comment has more replies to load in its reply tree.
 func (c *Comment) HasMore() bool {
 	return c.Replies.More != nil && len(c.Replies.More.Children) > 0
@@ -236,7 +299,8 @@ type Post struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	// Nil if it was never edited.
+	Edited *Timestamp `json:"edited,omitempty"`
 
 	Permalink string `json:"permalink,omitempty"`
 	URL       string `json:"url,omitempty"`
@@ -248,23 +312,278 @@ type Post struct {
 	// If neither, it will be nil.
 	Likes *bool `json:"likes"`
 
-	Score            int     `json:"score"`
-	UpvoteRatio      float32 `json:"upvote_ratio"`
-	NumberOfComments int     `json:"num_comments"`
+	Score              int     `json:"score"`
+	UpvoteRatio        float32 `json:"upvote_ratio"`
+	NumberOfComments   int     `json:"num_comments"`
+	NumberOfCrossposts int     `json:"num_crossposts"`
 
 	SubredditName         string `json:"subreddit,omitempty"`
 	SubredditNamePrefixed string `json:"subreddit_name_prefixed,omitempty"`
 	SubredditID           string `json:"subreddit_id,omitempty"`
 
-	Author   string `json:"author,omitempty"`
-	AuthorID string `json:"author_fullname,omitempty"`
+	Author                     string `json:"author,omitempty"`
+	AuthorID                   string `json:"author_fullname,omitempty"`
+	AuthorFlairText            string `json:"author_flair_text,omitempty"`
+	AuthorFlairID              string `json:"author_flair_template_id,omitempty"`
+	AuthorFlairBackgroundColor string `json:"author_flair_background_color,omitempty"`
+
+	// One of: moderator, admin, special.
+	// Empty if the post is not distinguished.
+	Distinguished string `json:"distinguished,omitempty"`
+	// Whether the post is shown to advertisers, e.g. all_ads, promo_adult_nsfw.
+	WhitelistStatus string `json:"whitelist_status,omitempty"`
+
+	Spoiler           bool `json:"spoiler"`
+	Locked            bool `json:"locked"`
+	NSFW              bool `json:"over_18"`
+	IsSelfPost        bool `json:"is_self"`
+	IsOriginalContent bool `json:"is_original_content"`
+	Saved             bool `json:"saved"`
+	Stickied          bool `json:"stickied"`
+	// Whether the post is pinned to its author's profile.
+	Pinned      bool `json:"pinned"`
+	ContestMode bool `json:"contest_mode"`
+
+	// The URL of the post's thumbnail image.
+	// Reddit uses placeholders such as "self", "default", "nsfw" and "spoiler" when there is none.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	IsVideo   bool   `json:"is_video"`
+	IsGallery bool   `json:"is_gallery"`
+
+	// Preview images of the post's link. Nil if Reddit did not generate any.
+	Preview *Preview `json:"preview,omitempty"`
+	// Nil unless the post is a video (or other embedded media).
+	Media *Media `json:"media,omitempty"`
+
+	// The order of the items of a gallery post.
+	// Nil if the post is not a gallery.
+	GalleryData *GalleryData `json:"gallery_data,omitempty"`
+	// The images and videos of a gallery post, keyed by their ID.
+	// Nil if the post is not a gallery.
+	MediaMetadata map[string]*MediaMetadata `json:"media_metadata,omitempty"`
+
+	// Nil if the post has not been gilded.
+	Gildings *Gildings `json:"gildings,omitempty"`
+	Awards   []*Award  `json:"all_awardings,omitempty"`
+	// The total number of awards received, i.e. the sum of the awards' counts.
+	TotalAwards int `json:"total_awards_received"`
+
+	// The full ID of the original post, if this is a crosspost.
+	CrosspostParentFullID string `json:"crosspost_parent,omitempty"`
+	// The original post, if this is a crosspost.
+	CrosspostParent *Post `json:"-"`
+
+	// Nil if the post is not a poll.
+	PollData *PollData `json:"poll_data,omitempty"`
+
+	// The subreddit the post is in.
+	// Nil unless requested with the IncludeSubredditDetail list option.
+	Subreddit *Subreddit `json:"sr_detail,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Post) UnmarshalJSON(data []byte) error {
+	type post Post
+	root := new(struct {
+		*post
+		CrosspostParentList []*Post `json:"crosspost_parent_list"`
+	})
+	root.post = (*post)(p)
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	if len(root.CrosspostParentList) > 0 {
+		p.CrosspostParent = root.CrosspostParentList[0]
+	}
+	p.Gildings, p.Awards = normalizeAwards(p.Gildings, p.Awards)
+	// Reddit sends false for posts that were never edited.
+	if p.Edited != nil && p.Edited.IsZero() {
+		p.Edited = nil
+	}
+
+	return nil
+}
+
+// normalizeAwards returns nil for empty gildings and awards,
+// since Reddit sends an empty object and array when a post or comment has none.
+func normalizeAwards(gildings *Gildings, awards []*Award) (*Gildings, []*Award) {
+	if gildings != nil && *gildings == (Gildings{}) {
+		gildings = nil
+	}
+	if len(awards) == 0 {
+		awards = nil
+	}
+	return gildings, awards
+}
+
+// Preview holds the preview images of a post.
+type Preview struct {
+	Images  []*PreviewImage `json:"images,omitempty"`
+	Enabled bool            `json:"enabled"`
+}
+
+// PreviewImage is a preview image of a post, available in several resolutions.
+type PreviewImage struct {
+	ID          string         `json:"id,omitempty"`
+	Source      *ImageSource   `json:"source,omitempty"`
+	Resolutions []*ImageSource `json:"resolutions,omitempty"`
+}
+
+// ImageSource is the URL and dimensions of an image.
+type ImageSource struct {
+	URL    string `json:"url,omitempty"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Media holds information about the media embedded in a post.
+type Media struct {
+	// Nil unless the media is a video hosted by Reddit.
+	RedditVideo *RedditVideo `json:"reddit_video,omitempty"`
+}
+
+// RedditVideo is a video hosted by Reddit.
+type RedditVideo struct {
+	// A direct link to the video, without audio.
+	FallbackURL string `json:"fallback_url,omitempty"`
+	DASHURL     string `json:"dash_url,omitempty"`
+	HLSURL      string `json:"hls_url,omitempty"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	// The length of the video, in seconds.
+	Duration int  `json:"duration"`
+	IsGIF    bool `json:"is_gif"`
+}
+
+// GalleryData holds the items of a gallery post, in order.
+type GalleryData struct {
+	Items []*GalleryItem `json:"items,omitempty"`
+}
+
+// GalleryItem is an item of a gallery post.
+// Its media can be found in the post's MediaMetadata, keyed by MediaID.
+type GalleryItem struct {
+	ID          int    `json:"id"`
+	MediaID     string `json:"media_id,omitempty"`
+	Caption     string `json:"caption,omitempty"`
+	OutboundURL string `json:"outbound_url,omitempty"`
+}
+
+// MediaMetadata holds information about an image or video of a gallery post.
+type MediaMetadata struct {
+	ID string `json:"id,omitempty"`
+	// The MIME type of the media, e.g. image/jpg.
+	MediaType string `json:"m,omitempty"`
+	Width     int    `json:"-"`
+	Height    int    `json:"-"`
+	// The URL of the source media.
+	URL string `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *MediaMetadata) UnmarshalJSON(data []byte) error {
+	type mediaMetadata MediaMetadata
+	root := new(struct {
+		*mediaMetadata
+		Source struct {
+			Width  int    `json:"x"`
+			Height int    `json:"y"`
+			URL    string `json:"u"`
+			// animated images have no u field
+			GIF string `json:"gif"`
+		} `json:"s"`
+	})
+	root.mediaMetadata = (*mediaMetadata)(m)
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	m.Width = root.Source.Width
+	m.Height = root.Source.Height
+	m.URL = root.Source.URL
+	if m.URL == "" {
+		m.URL = root.Source.GIF
+	}
+
+	return nil
+}
+
+// Gildings holds the number of times a post or comment was gilded, by type of gilding.
+type Gildings struct {
+	GildsSilver   int `json:"gid_1,omitempty"`
+	GildsGold     int `json:"gid_2,omitempty"`
+	GildsPlatinum int `json:"gid_3,omitempty"`
+}
+
+// Award is an award given to a post or comment.
+type Award struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+	// The number of Reddit coins the award costs.
+	CoinPrice int `json:"coin_price"`
+	// The number of times the award was given.
+	Count int `json:"count"`
+}
 
-	Spoiler    bool `json:"spoiler"`
-	Locked     bool `json:"locked"`
-	NSFW       bool `json:"over_18"`
-	IsSelfPost bool `json:"is_self"`
-	Saved      bool `json:"saved"`
-	Stickied   bool `json:"stickied"`
+// PollData holds information about a poll post.
+type PollData struct {
+	Options []*PollOption `json:"options,omitempty"`
+	// When voting on the poll closes.
+	VotingEndAt    *Timestamp `json:"voting_end_timestamp,omitempty"`
+	TotalVoteCount int        `json:"total_vote_count"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *PollData) UnmarshalJSON(data []byte) error {
+	type pollData PollData
+	root := new(struct {
+		*pollData
+		// Reddit sends this one in milliseconds.
+		VotingEndAt *int64 `json:"voting_end_timestamp"`
+	})
+	root.pollData = (*pollData)(d)
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	if root.VotingEndAt != nil {
+		d.VotingEndAt = &Timestamp{time.UnixMilli(*root.VotingEndAt).UTC()}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// VotingEndAt is marshalled in milliseconds, like Reddit sends it, so that it can be unmarshalled back.
+func (d PollData) MarshalJSON() ([]byte, error) {
+	type pollData PollData
+	root := struct {
+		pollData
+		VotingEndAt *int64 `json:"voting_end_timestamp,omitempty"`
+	}{pollData: pollData(d)}
+
+	if d.VotingEndAt != nil && !d.VotingEndAt.IsZero() {
+		ms := d.VotingEndAt.UnixMilli()
+		root.VotingEndAt = &ms
+	}
+
+	return json.Marshal(root)
+}
+
+// PollOption is an option of a poll post.
+type PollOption struct {
+	ID   string `json:"id,omitempty"`
+	Text string `json:"text,omitempty"`
+	// Nil unless you've voted on the poll or it has closed.
+	VoteCount *int `json:"vote_count,omitempty"`
 }
 
 // Subreddit holds information about a subreddit
@@ -273,20 +592,41 @@ type Subreddit struct {
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
 
-	URL                  string `json:"url,omitempty"`
-	Name                 string `json:"display_name,omitempty"`
-	NamePrefixed         string `json:"display_name_prefixed,omitempty"`
-	Title                string `json:"title,omitempty"`
-	Description          string `json:"public_description,omitempty"`
+	URL          string `json:"url,omitempty"`
+	Name         string `json:"display_name,omitempty"`
+	NamePrefixed string `json:"display_name_prefixed,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"public_description,omitempty"`
+	// One of: public, private, restricted, gold_restricted, archived, employees_only, user.
+	// It is kept as is if Reddit sends a value that isn't listed here.
 	Type                 string `json:"subreddit_type,omitempty"`
 	SuggestedCommentSort string `json:"suggested_comment_sort,omitempty"`
 
+	IconURL               string `json:"icon_img,omitempty"`
+	CommunityIconURL      string `json:"community_icon,omitempty"`
+	BannerBackgroundImage string `json:"banner_background_image,omitempty"`
+	BannerBackgroundColor string `json:"banner_background_color,omitempty"`
+	KeyColor              string `json:"key_color,omitempty"`
+	PrimaryColor          string `json:"primary_color,omitempty"`
+
+	// The labels of the buttons to submit a link and text post.
+	SubmitLinkLabel string `json:"submit_link_label,omitempty"`
+	SubmitTextLabel string `json:"submit_text_label,omitempty"`
+
 	Subscribers     int  `json:"subscribers"`
 	ActiveUserCount *int `json:"active_user_count,omitempty"`
-	NSFW            bool `json:"over18"`
-	UserIsMod       bool `json:"user_is_moderator"`
-	Subscribed      bool `json:"user_is_subscriber"`
-	Favorite        bool `json:"user_has_favorited"`
+	// Whether the active user count is randomly altered, which Reddit does for small subreddits.
+	ActiveUserCountIsFuzzed bool `json:"accounts_active_is_fuzzed"`
+	NSFW                    bool `json:"over18"`
+	UserIsMod               bool `json:"user_is_moderator"`
+	Subscribed              bool `json:"user_is_subscriber"`
+	Favorite                bool `json:"user_has_favorited"`
+
+	WikiEnabled      bool `json:"wiki_enabled"`
+	FreeFormReports  bool `json:"free_form_reports"`
+	AllowVideos      bool `json:"allow_videos"`
+	AllowImages      bool `json:"allow_images"`
+	ShowMediaPreview bool `json:"show_media_preview"`
 }
 
 func (l *rootListing) getComments() *Comments {
@@ -343,6 +683,12 @@ type Comments struct {
 	Before   string     `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of comments,
+// or nil if there is no next page.
+func (c *Comments) NextPageOptions() *ListOptions {
+	return nextPageOptions(c.After)
+}
+
 // Users is a list of users
 type Users struct {
 	Users  []*User `json:"users"`
@@ -350,6 +696,12 @@ type Users struct {
 	Before string  `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of users,
+// or nil if there is no next page.
+func (u *Users) NextPageOptions() *ListOptions {
+	return nextPageOptions(u.After)
+}
+
 // Subreddits is a list of subreddits
 type Subreddits struct {
 	Subreddits []*Subreddit `json:"subreddits"`
@@ -357,6 +709,12 @@ type Subreddits struct {
 	Before     string       `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of subreddits,
+// or nil if there is no next page.
+func (s *Subreddits) NextPageOptions() *ListOptions {
+	return nextPageOptions(s.After)
+}
+
 // Posts is a list of posts.
 type Posts struct {
 	Posts  []*Post `json:"posts"`
@@ -364,6 +722,12 @@ type Posts struct {
 	Before string  `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of posts,
+// or nil if there is no next page.
+func (p *Posts) NextPageOptions() *ListOptions {
+	return nextPageOptions(p.After)
+}
+
 // ModActions is a list of moderator actions.
 type ModActions struct {
 	ModActions []*ModAction `json:"moderator_actions"`
@@ -371,6 +735,12 @@ type ModActions struct {
 	Before     string       `json:"before"`
 }
 
+// NextPageOptions returns options pre-populated with the cursor to the next page of moderator actions,
+// or nil if there is no next page.
+func (m *ModActions) NextPageOptions() *ListOptions {
+	return nextPageOptions(m.After)
+}
+
 // PostAndComments is a post and its comments.
 type PostAndComments struct {
 	Post     *Post      `json:"post"`
@@ -426,3 +796,75 @@ func (pc *PostAndComments) addMoreToTree(more *More) {
 		reply.addMoreToReplies(more)
 	}
 }
+
+// Walk traverses the post's comment tree depth-first, calling fn for each comment before its replies.
+// The traversal stops as soon as fn returns false.
+// "More" stubs are not visited, use Mores to get them.
+func (pc *PostAndComments) Walk(fn func(*Comment) bool) {
+	walkComments(pc.Comments, fn)
+}
+
+// Flatten returns every comment in the post's comment tree in depth-first order,
+// i.e. each comment is followed by its replies.
+func (pc *PostAndComments) Flatten() []*Comment {
+	var comments []*Comment
+	pc.Walk(func(c *Comment) bool {
+		comments = append(comments, c)
+		return true
+	})
+	return comments
+}
+
+// Mores returns the "more" stubs found throughout the post's comment tree,
+// i.e. the entrypoints to the comments that were left out of it.
+func (pc *PostAndComments) Mores() []*More {
+	var mores []*More
+	if pc.More != nil {
+		mores = append(mores, pc.More)
+	}
+	pc.Walk(func(c *Comment) bool {
+		if c.Replies.More != nil {
+			mores = append(mores, c.Replies.More)
+		}
+		return true
+	})
+	return mores
+}
+
+// Walk traverses the comment and its replies depth-first, calling fn for each comment before its replies.
+// The traversal stops as soon as fn returns false.
+func (c *Comment) Walk(fn func(*Comment) bool) {
+	walkComments([]*Comment{c}, fn)
+}
+
+// Flatten returns the comment followed by all of its replies in depth-first order.
+func (c *Comment) Flatten() []*Comment {
+	var comments []*Comment
+	c.Walk(func(c *Comment) bool {
+		comments = append(comments, c)
+		return true
+	})
+	return comments
+}
+
+// walkComments uses a stack rather than recursion so that deeply nested trees can't overflow it.
+func walkComments(comments []*Comment, fn func(*Comment) bool) {
+	stack := make([]*Comment, 0, len(comments))
+	for i := len(comments) - 1; i >= 0; i-- {
+		stack = append(stack, comments[i])
+	}
+
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if c == nil {
+			continue
+		}
+		if !fn(c) {
+			return
+		}
+		for i := len(c.Replies.Comments) - 1; i >= 0; i-- {
+			stack = append(stack, c.Replies.Comments[i])
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package reddit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThings_UnmarshalJSON(t *testing.T) {
+	blob, err := readFileContents("../testdata/listings/mixed.json")
+	require.NoError(t, err)
+
+	root := new(rootListing)
+	err = json.Unmarshal([]byte(blob), root)
+	require.NoError(t, err)
+
+	things := root.Data.Things
+
+	require.Len(t, things.Comments, 1)
+	require.Equal(t, "t1_g05v931", things.Comments[0].FullID)
+
+	require.Len(t, things.Users, 1)
+	require.Equal(t, "testuser", things.Users[0].Name)
+
+	require.Len(t, things.Posts, 1)
+	require.Equal(t, "t3_i2gvg4", things.Posts[0].FullID)
+
+	require.Len(t, things.Messages, 1)
+	require.Equal(t, "t4_qwki97", things.Messages[0].FullID)
+	require.Equal(t, "Test message", things.Messages[0].Text)
+
+	require.Len(t, things.Subreddits, 1)
+	require.Equal(t, "t5_2qh23", things.Subreddits[0].FullID)
+
+	require.Equal(t, []*Trophy{{ID: "1ug4t", Name: "Verified Email", Description: "Since 2020"}}, things.Trophies)
+
+	require.Empty(t, things.Mores)
+	require.Empty(t, things.ModActions)
+}
+
+func newCommentTree() *PostAndComments {
+	return &PostAndComments{
+		Post: &Post{FullID: "t3_p"},
+		Comments: []*Comment{
+			{
+				ID: "c1",
+				Replies: Replies{
+					Comments: []*Comment{
+						{
+							ID: "c1a",
+							Replies: Replies{
+								Comments: []*Comment{{ID: "c1a1"}},
+								More:     &More{ID: "m1a", Children: []string{"c1a2"}},
+							},
+						},
+						{ID: "c1b"},
+					},
+				},
+			},
+			{ID: "c2"},
+		},
+		More: &More{ID: "m", Children: []string{"c3"}},
+	}
+}
+
+func commentIDs(comments []*Comment) []string {
+	ids := make([]string, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func TestPostAndComments_Flatten(t *testing.T) {
+	pc := newCommentTree()
+	require.Equal(t, []string{"c1", "c1a", "c1a1", "c1b", "c2"}, commentIDs(pc.Flatten()))
+	require.Equal(t, []string{"c1a", "c1a1"}, commentIDs(pc.Comments[0].Replies.Comments[0].Flatten()))
+
+	require.Empty(t, (&PostAndComments{}).Flatten())
+}
+
+func TestPostAndComments_Walk(t *testing.T) {
+	pc := newCommentTree()
+
+	var ids []string
+	pc.Walk(func(c *Comment) bool {
+		ids = append(ids, c.ID)
+		return c.ID != "c1a1"
+	})
+	require.Equal(t, []string{"c1", "c1a", "c1a1"}, ids)
+
+	ids = nil
+	pc.Comments[0].Walk(func(c *Comment) bool {
+		ids = append(ids, c.ID)
+		return true
+	})
+	require.Equal(t, []string{"c1", "c1a", "c1a1", "c1b"}, ids)
+}
+
+func TestPostAndComments_Walk_Deep(t *testing.T) {
+	root := &Comment{ID: "0"}
+	c := root
+	for i := 0; i < 100000; i++ {
+		reply := &Comment{}
+		c.Replies.Comments = []*Comment{reply}
+		c = reply
+	}
+
+	pc := &PostAndComments{Comments: []*Comment{root}}
+	require.Len(t, pc.Flatten(), 100001)
+}
+
+func TestPostAndComments_Mores(t *testing.T) {
+	pc := newCommentTree()
+
+	mores := pc.Mores()
+	require.Len(t, mores, 2)
+	require.Equal(t, "m", mores[0].ID)
+	require.Equal(t, "m1a", mores[1].ID)
+}
+
+func TestPost_UnmarshalJSON(t *testing.T) {
+	blob, err := readFileContents("../testdata/listings/posts-extra-fields.json")
+	require.NoError(t, err)
+
+	root := new(rootListing)
+	err = json.Unmarshal([]byte(blob), root)
+	require.NoError(t, err)
+
+	posts := root.Data.Things.Posts
+	require.Len(t, posts, 2)
+
+	crosspost := posts[0]
+	require.Nil(t, crosspost.Gildings)
+	require.Nil(t, crosspost.Awards)
+	require.Nil(t, crosspost.PollData)
+	require.Equal(t, "t3_k3mn1a", crosspost.CrosspostParentFullID)
+	require.Equal(t, &Post{
+		ID:            "k3mn1a",
+		FullID:        "t3_k3mn1a",
+		Title:         "Original post",
+		SubredditName: "test",
+		Score:         250,
+		Gildings:      &Gildings{GildsSilver: 1, GildsGold: 2},
+		Awards: []*Award{
+			{Name: "Gold", Description: "Gives the author a week of Reddit Premium.", IconURL: "https://www.redditstatic.com/gold/awards/icon/gold_512.png", CoinPrice: 500, Count: 2},
+			{Name: "Silver", Description: "Shows the Silver Award... and that's it.", IconURL: "https://www.redditstatic.com/gold/awards/icon/silver_512.png", CoinPrice: 100, Count: 1},
+		},
+		TotalAwards: 3,
+	}, crosspost.CrosspostParent)
+
+	poll := posts[1]
+	require.Empty(t, poll.CrosspostParentFullID)
+	require.Nil(t, poll.CrosspostParent)
+	require.Equal(t, &Gildings{GildsPlatinum: 1}, poll.Gildings)
+	require.Equal(t, &PollData{
+		Options: []*PollOption{
+			{ID: "5544001", Text: "Yes", VoteCount: Int(12)},
+			{ID: "5544002", Text: "No", VoteCount: Int(8)},
+		},
+		VotingEndAt:    &Timestamp{time.Date(2020, 12, 3, 0, 0, 0, 0, time.UTC)},
+		TotalVoteCount: 20,
+	}, poll.PollData)
+}
+
+func TestPollData_MarshalJSON(t *testing.T) {
+	pollData := &PollData{
+		Options: []*PollOption{
+			{ID: "5544001", Text: "Yes", VoteCount: Int(12)},
+		},
+		VotingEndAt:    &Timestamp{time.Date(2020, 12, 3, 0, 0, 0, 0, time.UTC)},
+		TotalVoteCount: 12,
+	}
+
+	b, err := json.Marshal(pollData)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"options": [{"id": "5544001", "text": "Yes", "vote_count": 12}],
+		"voting_end_timestamp": 1606953600000,
+		"total_vote_count": 12
+	}`, string(b))
+
+	got := new(PollData)
+	err = json.Unmarshal(b, got)
+	require.NoError(t, err)
+	require.Equal(t, pollData, got)
+
+	// round trip through a post
+	b, err = json.Marshal(&Post{ID: "test", PollData: pollData})
+	require.NoError(t, err)
+
+	post := new(Post)
+	err = json.Unmarshal(b, post)
+	require.NoError(t, err)
+	require.Equal(t, pollData, post.PollData)
+
+	b, err = json.Marshal(&PollData{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"total_vote_count": 0}`, string(b))
+}
+
+func TestComment_UnmarshalJSON(t *testing.T) {
+	comment := new(Comment)
+	err := json.Unmarshal([]byte(`{
+		"id": "g05v931",
+		"body": "Test comment",
+		"body_html": "&lt;div class=\"md\"&gt;&lt;p&gt;Test comment&lt;/p&gt;\n&lt;/div&gt;",
+		"depth": 2,
+		"distinguished": "moderator",
+		"replies": ""
+	}`), comment)
+	require.NoError(t, err)
+	require.Equal(t, &Comment{
+		ID:            "g05v931",
+		Body:          "Test comment",
+		BodyHTML:      "&lt;div class=\"md\"&gt;&lt;p&gt;Test comment&lt;/p&gt;\n&lt;/div&gt;",
+		Depth:         2,
+		Distinguished: "moderator",
+	}, comment)
+}
+
+func TestPostAndComment_UnmarshalJSON_Edited(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want *Timestamp
+	}{
+		{`false`, nil},
+		{`null`, nil},
+		{`1595469764.0`, &Timestamp{time.Date(2020, 7, 23, 2, 2, 44, 0, time.UTC)}},
+		{`0`, &Timestamp{time.Unix(0, 0).UTC()}},
+	}
+	for _, tc := range tests {
+		post := new(Post)
+		err := json.Unmarshal([]byte(`{"id": "p", "edited": `+tc.raw+`}`), post)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, post.Edited, tc.raw)
+
+		comment := new(Comment)
+		err = json.Unmarshal([]byte(`{"id": "c", "edited": `+tc.raw+`, "replies": ""}`), comment)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, comment.Edited, tc.raw)
+	}
+}
+
+func TestPost_UnmarshalJSON_Gallery(t *testing.T) {
+	blob, err := readFileContents("../testdata/listings/gallery-post.json")
+	require.NoError(t, err)
+
+	root := new(rootListing)
+	err = json.Unmarshal([]byte(blob), root)
+	require.NoError(t, err)
+
+	posts := root.Data.Things.Posts
+	require.Len(t, posts, 1)
+	require.Equal(t, &Post{
+		ID:            "k4g4ll",
+		FullID:        "t3_k4g4ll",
+		Title:         "Gallery post",
+		SubredditName: "test",
+
+		Author:                     "testuser",
+		AuthorID:                   "t2_testuser",
+		AuthorFlairText:            "OC Creator",
+		AuthorFlairID:              "0f6c3f8e-05ca-11e1-96f4-12313d096aae",
+		AuthorFlairBackgroundColor: "#ff4500",
+
+		Distinguished:   "moderator",
+		WhitelistStatus: "all_ads",
+
+		IsOriginalContent: true,
+		Pinned:            true,
+		ContestMode:       true,
+		IsGallery:         true,
+
+		GalleryData: &GalleryData{
+			Items: []*GalleryItem{
+				{ID: 20138416, MediaID: "a1b2c3"},
+				{ID: 20138417, MediaID: "d4e5f6"},
+			},
+		},
+		MediaMetadata: map[string]*MediaMetadata{
+			"a1b2c3": {
+				ID:        "a1b2c3",
+				MediaType: "image/jpg",
+				Width:     1920,
+				Height:    1080,
+				URL:       "https://preview.redd.it/a1b2c3.jpg?width=1920&amp;format=pjpg&amp;auto=webp",
+			},
+			"d4e5f6": {
+				ID:        "d4e5f6",
+				MediaType: "image/gif",
+				Width:     400,
+				Height:    300,
+				URL:       "https://i.redd.it/d4e5f6.gif",
+			},
+		},
+	}, posts[0])
+}
+
+func TestComment_UnmarshalJSON_Awards(t *testing.T) {
+	comment := new(Comment)
+	err := json.Unmarshal([]byte(`{
+		"id": "g05v931",
+		"collapsed": true,
+		"collapsed_reason": "comment score below threshold",
+		"gildings": {"gid_1": 2},
+		"all_awardings": [
+			{
+				"id": "gid_1",
+				"name": "Silver",
+				"description": "Shows the Silver Award... and that's it.",
+				"icon_url": "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				"coin_price": 100,
+				"count": 2
+			}
+		],
+		"total_awards_received": 2,
+		"replies": ""
+	}`), comment)
+	require.NoError(t, err)
+	require.Equal(t, &Comment{
+		ID:              "g05v931",
+		Collapsed:       true,
+		CollapsedReason: "comment score below threshold",
+		Gildings:        &Gildings{GildsSilver: 2},
+		Awards: []*Award{
+			{Name: "Silver", Description: "Shows the Silver Award... and that's it.", IconURL: "https://www.redditstatic.com/gold/awards/icon/silver_512.png", CoinPrice: 100, Count: 2},
+		},
+		TotalAwards: 2,
+	}, comment)
+
+	comment = new(Comment)
+	err = json.Unmarshal([]byte(`{"id": "g05v931", "gildings": {}, "all_awardings": [], "replies": ""}`), comment)
+	require.NoError(t, err)
+	require.Nil(t, comment.Gildings)
+	require.Nil(t, comment.Awards)
+}
+
+func TestThings_UnmarshalJSON_Unknown(t *testing.T) {
+	root := new(rootListing)
+	err := json.Unmarshal([]byte(`{
+		"kind": "Listing",
+		"data": {
+			"children": [
+				{"kind": "t9", "data": {"id": "future", "name": "t9_future"}},
+				{"kind": "t1", "data": {"id": "test", "name": "t1_test", "distinguished": "community_champion", "replies": ""}},
+				{"kind": "t5", "data": {"id": "test", "name": "t5_test", "subreddit_type": "gold_restricted_archived"}}
+			]
+		}
+	}`), root)
+	require.NoError(t, err)
+
+	things := root.Data.Things
+	require.Len(t, things.Comments, 1)
+	require.Equal(t, "community_champion", things.Comments[0].Distinguished)
+	require.Len(t, things.Subreddits, 1)
+	require.Equal(t, "gold_restricted_archived", things.Subreddits[0].Type)
+}
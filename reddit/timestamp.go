@@ -1,7 +1,9 @@
 package reddit
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,35 +14,66 @@ type Timestamp struct {
 }
 
 // MarshalJSON implements the json.Marshaler interface.
-func (t *Timestamp) MarshalJSON() ([]byte, error) {
-	if t == nil || t.Time.IsZero() {
+// The time is marshalled as a Unix timestamp in seconds, like Reddit sends it, e.g. 1136214245.0.
+// Fractions of a second are kept, e.g. 1136214245.5.
+// A zero time is marshalled as false, which is how Reddit indicates that e.g. a post was never edited.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() {
 		return []byte(`false`), nil
 	}
 
-	parsed := t.Time.Format(time.RFC3339)
-	return []byte(`"` + parsed + `"`), nil
+	frac := strings.TrimRight(fmt.Sprintf("%09d", t.Nanosecond()), "0")
+	if frac == "" {
+		frac = "0"
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10) + "." + frac), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format, and is converted to UTC.
+// false and null are unmarshalled as a zero time.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
 
 	// "edited" for posts and comments is either false, or a timestamp.
-	if str == "false" {
+	if str == "false" || str == "null" {
+		t.Time = time.Time{}
 		return
 	}
 
 	f, err := strconv.ParseFloat(str, 64)
 	if err == nil {
-		t.Time = time.Unix(int64(f), 0).UTC()
+		t.Time = unixTime(str, f).UTC()
 	} else {
 		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+		t.Time = t.Time.UTC()
 	}
 
 	return
 }
 
+// unixTime returns the time of the Unix timestamp str, whose value as a float is f.
+// The seconds and their fraction are parsed separately when possible, so that no precision is lost.
+func unixTime(str string, f float64) time.Time {
+	sec, frac, _ := strings.Cut(str, ".")
+	s, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil || s < 0 {
+		return time.Unix(0, int64(f*float64(time.Second)))
+	}
+
+	if len(frac) > 9 {
+		frac = frac[:9]
+	}
+	var ns int64
+	if frac != "" {
+		ns, err = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+		if err != nil {
+			return time.Unix(0, int64(f*float64(time.Second)))
+		}
+	}
+	return time.Unix(s, ns)
+}
+
 // Equal reports whether t and u are equal based on time.Equal
 func (t Timestamp) Equal(u Timestamp) bool {
 	return t.Time.Equal(u.Time)
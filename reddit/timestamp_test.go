@@ -8,9 +8,10 @@ import (
 )
 
 const (
-	emptyTimeStr         = `"0001-01-01T00:00:00Z"`
-	referenceTimeStr     = `"2006-01-02T15:04:05Z"`
-	referenceUnixTimeStr = `1136214245`
+	emptyTimeStr              = `"0001-01-01T00:00:00Z"`
+	referenceTimeStr          = `"2006-01-02T15:04:05Z"`
+	referenceUnixTimeStr      = `1136214245`
+	referenceUnixFloatTimeStr = `1136214245.0`
 )
 
 var (
@@ -26,9 +27,10 @@ func TestTimestamp_Marshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", Timestamp{referenceTime}, referenceTimeStr, false, true},
-		{"Empty", Timestamp{}, emptyTimeStr, false, true},
-		{"Mismatch", Timestamp{}, referenceTimeStr, false, false},
+		{"Reference", Timestamp{referenceTime}, referenceUnixFloatTimeStr, false, true},
+		{"Empty", Timestamp{}, `false`, false, true},
+		{"UnixStart", Timestamp{unixOrigin}, `0.0`, false, true},
+		{"Mismatch", Timestamp{}, referenceUnixFloatTimeStr, false, false},
 	}
 	for _, tc := range testCases {
 		out, err := json.Marshal(tc.data)
@@ -53,8 +55,12 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 	}{
 		{"Reference", referenceTimeStr, Timestamp{referenceTime}, false, true},
 		{"ReferenceUnix", referenceUnixTimeStr, Timestamp{referenceTime}, false, true},
+		{"ReferenceUnixFloat", referenceUnixFloatTimeStr, Timestamp{referenceTime}, false, true},
 		{"Empty", emptyTimeStr, Timestamp{}, false, true},
+		{"False", `false`, Timestamp{}, false, true},
+		{"Null", `null`, Timestamp{}, false, true},
 		{"UnixStart", `0`, Timestamp{unixOrigin}, false, true},
+		{"UnixStartFloat", `0.0`, Timestamp{unixOrigin}, false, true},
 		{"Mismatch", referenceTimeStr, Timestamp{}, false, false},
 		{"MismatchUnix", `0`, Timestamp{}, false, false},
 		{"Invalid", `"asdf"`, Timestamp{referenceTime}, true, false},
@@ -80,6 +86,7 @@ func TestTimstamp_MarshalReflexivity(t *testing.T) {
 	}{
 		{"Reference", Timestamp{referenceTime}},
 		{"Empty", Timestamp{}},
+		{"UnixStart", Timestamp{unixOrigin}},
 	}
 	for _, tc := range testCases {
 		data, err := json.Marshal(tc.data)
@@ -110,9 +117,9 @@ func TestWrappedTimstamp_Marshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", WrappedTimestamp{0, Timestamp{referenceTime}}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceTimeStr), false, true},
-		{"Empty", WrappedTimestamp{}, fmt.Sprintf(`{"A":0,"Time":%s}`, emptyTimeStr), false, true},
-		{"Mismatch", WrappedTimestamp{}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceTimeStr), false, false},
+		{"Reference", WrappedTimestamp{0, Timestamp{referenceTime}}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceUnixFloatTimeStr), false, true},
+		{"Empty", WrappedTimestamp{}, `{"A":0,"Time":false}`, false, true},
+		{"Mismatch", WrappedTimestamp{}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceUnixFloatTimeStr), false, false},
 	}
 	for _, tc := range testCases {
 		out, err := json.Marshal(tc.data)
@@ -180,3 +187,87 @@ func TestWrappedTimstamp_MarshalReflexivity(t *testing.T) {
 		}
 	}
 }
+
+func TestTimestamp_Unmarshal_UTC(t *testing.T) {
+	for _, data := range []string{
+		`"2006-01-02T15:04:05Z"`,
+		`"2006-01-02T15:04:05+00:00"`,
+		`"2006-01-02T10:04:05-05:00"`,
+		`1136214245`,
+	} {
+		var got Timestamp
+		err := json.Unmarshal([]byte(data), &got)
+		if err != nil {
+			t.Fatalf("%s: Unmarshal err=%v", data, err)
+		}
+		if got.Time != referenceTime {
+			t.Fatalf("%s: got=%v, want=%v in UTC", data, got.Time, referenceTime)
+		}
+	}
+}
+
+func TestTimestamp_IsZero(t *testing.T) {
+	// IsZero is promoted from time.Time, so it can be called on values too
+	var _ interface{ IsZero() bool } = Timestamp{}
+	if !(Timestamp{}).IsZero() {
+		t.Fatal("empty timestamp should be zero")
+	}
+
+	var got Timestamp
+	err := json.Unmarshal([]byte(`false`), &got)
+	if err != nil {
+		t.Fatalf("Unmarshal err=%v", err)
+	}
+	if !got.IsZero() {
+		t.Fatalf("%+v should be zero", got)
+	}
+
+	err = json.Unmarshal([]byte(`0.0`), &got)
+	if err != nil {
+		t.Fatalf("Unmarshal err=%v", err)
+	}
+	if got.IsZero() {
+		t.Fatalf("%+v should not be zero", got)
+	}
+}
+
+func TestTimestamp_MarshalFraction(t *testing.T) {
+	testCases := []struct {
+		desc string
+		data string
+		want time.Time
+		out  string
+	}{
+		{"RFC3339", `"2020-09-05T14:29:44.546209+00:00"`, time.Date(2020, 9, 5, 14, 29, 44, 546209000, time.UTC), `1599316184.546209`},
+		{"Unix", `1599316184.546209`, time.Date(2020, 9, 5, 14, 29, 44, 546209000, time.UTC), `1599316184.546209`},
+		{"UnixNano", `1599316184.123456789123`, time.Date(2020, 9, 5, 14, 29, 44, 123456789, time.UTC), `1599316184.123456789`},
+		{"UnixWhole", `1599316184.0`, time.Date(2020, 9, 5, 14, 29, 44, 0, time.UTC), `1599316184.0`},
+	}
+	for _, tc := range testCases {
+		var got Timestamp
+		err := json.Unmarshal([]byte(tc.data), &got)
+		if err != nil {
+			t.Fatalf("%s: Unmarshal err=%v", tc.desc, err)
+		}
+		if got.Time != tc.want {
+			t.Fatalf("%s: got=%v, want=%v", tc.desc, got.Time, tc.want)
+		}
+
+		out, err := json.Marshal(got)
+		if err != nil {
+			t.Fatalf("%s: Marshal err=%v", tc.desc, err)
+		}
+		if string(out) != tc.out {
+			t.Fatalf("%s: got=%s, want=%s", tc.desc, out, tc.out)
+		}
+
+		var roundTrip Timestamp
+		err = json.Unmarshal(out, &roundTrip)
+		if err != nil {
+			t.Fatalf("%s: Unmarshal err=%v", tc.desc, err)
+		}
+		if roundTrip.Time != tc.want {
+			t.Fatalf("%s: round trip got=%v, want=%v", tc.desc, roundTrip.Time, tc.want)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 )
 
 // UserService handles communication with the user
@@ -27,14 +29,33 @@ type User struct {
 	Name    string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
 
+	IconURL      string `json:"icon_img,omitempty"`
+	SnoovatarURL string `json:"snoovatar_img,omitempty"`
+
 	PostKarma    int `json:"link_karma"`
 	CommentKarma int `json:"comment_karma"`
+	// Karma from receiving awards.
+	AwardeeKarma int `json:"awardee_karma"`
+	// Karma from giving awards.
+	AwarderKarma int `json:"awarder_karma"`
 
 	IsFriend         bool `json:"is_friend"`
+	IsBlocked        bool `json:"is_blocked"`
 	IsEmployee       bool `json:"is_employee"`
+	IsGold           bool `json:"is_gold"`
+	IsMod            bool `json:"is_mod"`
 	HasVerifiedEmail bool `json:"has_verified_email"`
+	AcceptFollowers  bool `json:"accept_followers"`
 	NSFW             bool `json:"over_18"`
 	IsSuspended      bool `json:"is_suspended"`
+
+	// Only returned for the authenticated user.
+	Modhash string `json:"modhash,omitempty"`
+}
+
+// TotalKarma returns the sum of the user's post, comment, awardee and awarder karma.
+func (u *User) TotalKarma() int {
+	return u.PostKarma + u.CommentKarma + u.AwardeeKarma + u.AwarderKarma
 }
 
 // UserSummary represents a Reddit user, but
@@ -73,6 +94,16 @@ type Trophy struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	// The URL linked to by the trophy, if any.
+	URL string `json:"url,omitempty"`
+
+	// URLs of the trophy's icon, 70x70 and 40x40 pixels.
+	Icon70 string `json:"icon_70,omitempty"`
+	Icon40 string `json:"icon_40,omitempty"`
+
+	AwardID string `json:"award_id,omitempty"`
+	// When the trophy was granted. Not always provided.
+	GrantedAt *Timestamp `json:"granted_at,omitempty"`
 }
 
 // Get returns information about the user.
@@ -119,8 +150,17 @@ func (s *UserService) GetMultipleByID(ctx context.Context, ids ...string) (map[s
 	return root, resp, nil
 }
 
+// usernameRegex is the format Reddit requires for the names of new accounts.
+var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{3,20}$`)
+
 // UsernameAvailable checks whether a username is available for registration.
+// If the username is not 3 to 20 letters, numbers, underscores or hyphens, ErrInvalidUsername
+// is returned without making a request.
 func (s *UserService) UsernameAvailable(ctx context.Context, username string) (bool, *Response, error) {
+	if !usernameRegex.MatchString(username) {
+		return false, nil, fmt.Errorf("%w, got %q", ErrInvalidUsername, username)
+	}
+
 	type params struct {
 		User string `url:"user"`
 	}
@@ -563,6 +603,10 @@ func (s *UserService) New(ctx context.Context, opts *ListUserOverviewOptions) (*
 // Search searches for users.
 // todo: maybe include the sort option? (relevance, activity)
 func (s *UserService) Search(ctx context.Context, query string, opts *ListOptions) (*Users, *Response, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil, ErrEmptyQuery
+	}
+
 	path := "users/search"
 	path, err := addOptions(path, opts)
 	if err != nil {
@@ -16,10 +16,17 @@ var expectedUser = &User{
 	Name:    "Test_User",
 	Created: &Timestamp{time.Date(2012, 10, 18, 10, 11, 11, 0, time.UTC)},
 
+	IconURL:      "https://www.redditstatic.com/avatars/avatar_default_16_25B79F.png",
+	SnoovatarURL: "https://i.redd.it/snoovatar/avatars/4a6a6d29-2f35-4b5a-9f1b-6e8d32e8b6a1.png",
+
 	PostKarma:    8239,
 	CommentKarma: 130514,
+	AwardeeKarma: 1240,
+	AwarderKarma: 75,
 
+	IsMod:            true,
 	HasVerifiedEmail: true,
+	AcceptFollowers:  true,
 }
 
 var expectedUsers = map[string]*UserSummary{
@@ -50,10 +57,10 @@ var expectedPost = &Post{
 	ID:      "gczwql",
 	FullID:  "t3_gczwql",
 	Created: &Timestamp{time.Date(2020, 5, 3, 22, 46, 25, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	Permalink: "/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
 	URL:       "https://www.reddit.com/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
+	Thumbnail: "self",
 
 	Title: "GET /user/{username}/gilded: does it return other user's things you've gilded, or your things that have been gilded? Does it return both comments and posts?",
 	Body:  "Talking about [this](https://www.reddit.com/dev/api/#GET_user_{username}_{where}) endpoint specifically.\n\nI'm building a Reddit API client, but don't have gold.",
@@ -71,6 +78,8 @@ var expectedPost = &Post{
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
 
+	WhitelistStatus: "all_ads",
+
 	IsSelfPost: true,
 }
 
@@ -78,12 +87,12 @@ var expectedComment = &Comment{
 	ID:      "f0zsa37",
 	FullID:  "t1_f0zsa37",
 	Created: &Timestamp{time.Date(2019, 9, 21, 21, 38, 16, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	ParentID:  "t3_d7ejpn",
 	Permalink: "/r/apple/comments/d7ejpn/im_giving_away_an_iphone_11_pro_to_a_commenter_at/f0zsa37/",
 
 	Body:     "Thank you!",
+	BodyHTML: "&lt;div class=\"md\"&gt;&lt;p&gt;Thank you!&lt;/p&gt;\n&lt;/div&gt;",
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
 
@@ -121,11 +130,17 @@ var expectedTrophies = []Trophy{
 		ID:          "",
 		Name:        "Three-Year Club",
 		Description: "",
+		Icon70:      "https://www.redditstatic.com/awards2/3_year_club-70.png",
+		Icon40:      "https://www.redditstatic.com/awards2/3_year_club-40.png",
 	},
 	{
 		ID:          "1q1tez",
 		Name:        "Verified Email",
 		Description: "",
+		Icon70:      "https://www.redditstatic.com/awards2/verified_email-70.png",
+		Icon40:      "https://www.redditstatic.com/awards2/verified_email-40.png",
+		AwardID:     "o",
+		GrantedAt:   &Timestamp{time.Date(2020, 6, 16, 16, 49, 50, 0, time.UTC)},
 	},
 }
 
@@ -142,6 +157,13 @@ var expectedUserSubreddits = &Subreddits{
 			Title:        "nickofnight",
 			Description:  "Stories written for Writing Prompts, NoSleep, and originals. Current series: The Carnival of Night ",
 			Type:         "user",
+
+			IconURL:          "https://styles.redditmedia.com/t5_3kefx/styles/profileIcon_w1vytyimts541.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=e722798c6253d3ae3990bf42c3ae844d7c2a924b",
+			KeyColor:         "#222222",
+			FreeFormReports:  true,
+			AllowVideos:      true,
+			AllowImages:      true,
+			ShowMediaPreview: true,
 		},
 		{
 			ID:      "3knn1",
@@ -155,6 +177,12 @@ var expectedUserSubreddits = &Subreddits{
 			Description:          "In nineteen ninety eight the undertaker threw mankind off hеll in a cell, and plummeted sixteen feet through an announcer's table.",
 			Type:                 "user",
 			SuggestedCommentSort: "qa",
+
+			IconURL:          "https://styles.redditmedia.com/t5_3knn1/styles/profileIcon_b51xzp4vbvs41.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=6535d6f05d037d43d72217899d3f81aba4fb442d",
+			FreeFormReports:  true,
+			AllowVideos:      true,
+			AllowImages:      true,
+			ShowMediaPreview: true,
 		},
 	},
 	After: "t5_3knn1",
@@ -167,9 +195,13 @@ var expectedSearchUsers = &Users{
 			Name:    "washingtonpost",
 			Created: &Timestamp{time.Date(2017, 4, 20, 21, 23, 58, 0, time.UTC)},
 
+			IconURL: "https://styles.redditmedia.com/t5_3kdh5/styles/profileIcon_0ws73gmqq8t21.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=a4d69298f5514b44cfa28a428c0953ebe0d5f6a1",
+
 			PostKarma:    1075227,
 			CommentKarma: 339569,
 
+			IsGold:           true,
+			IsMod:            true,
 			HasVerifiedEmail: true,
 		},
 		{
@@ -177,9 +209,12 @@ var expectedSearchUsers = &Users{
 			Name:    "reuters",
 			Created: &Timestamp{time.Date(2018, 3, 15, 1, 50, 4, 0, time.UTC)},
 
+			IconURL: "https://styles.redditmedia.com/t5_i4xj7/styles/profileIcon_mlsb0hlsebs01.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=7cb6c6fcf5079cd5514ea626e73398429f3b4b54",
+
 			PostKarma:    76744,
 			CommentKarma: 42717,
 
+			IsGold:           true,
 			HasVerifiedEmail: true,
 		},
 	},
@@ -201,6 +236,7 @@ func TestUserService_Get(t *testing.T) {
 	user, _, err := client.User.Get(ctx, "Test_User")
 	require.NoError(t, err)
 	require.Equal(t, expectedUser, user)
+	require.Equal(t, 140068, user.TotalKarma())
 }
 
 func TestUserService_GetMultipleByID(t *testing.T) {
@@ -249,6 +285,13 @@ func TestUserService_UsernameAvailable(t *testing.T) {
 	ok, _, err = client.User.UsernameAvailable(ctx, "123test")
 	require.NoError(t, err)
 	require.False(t, ok)
+
+	for _, username := range []string{"", "ab", "test user", "test.user", "thisusernameistoolong"} {
+		_, _, err = client.User.UsernameAvailable(ctx, username)
+		require.ErrorIs(t, err, ErrInvalidUsername, username)
+	}
+	_, _, err = client.User.UsernameAvailable(ctx, "test!")
+	require.EqualError(t, err, `username: must be 3 to 20 letters, numbers, underscores or hyphens, got "test!"`)
 }
 
 func TestUserService_Overview(t *testing.T) {
@@ -330,7 +373,7 @@ func TestUserService_Overview_Options(t *testing.T) {
 			Limit: 5,
 			After: "t3_after",
 		},
-		Sort: "top",
+		Sort: SortTop,
 	})
 	require.NoError(t, err)
 }
@@ -402,7 +445,7 @@ func TestUserService_Posts_Options(t *testing.T) {
 		ListOptions: ListOptions{
 			Limit: 10,
 		},
-		Sort: "new",
+		Sort: SortNew,
 	})
 	require.NoError(t, err)
 }
@@ -532,7 +575,7 @@ func TestUserService_Saved_Options(t *testing.T) {
 		ListOptions: ListOptions{
 			Limit: 50,
 		},
-		Sort: "controversial",
+		Sort: SortControversial,
 	})
 	require.NoError(t, err)
 }
@@ -972,6 +1015,9 @@ func TestUserService_Search(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
+	_, _, err = client.User.Search(ctx, " ", nil)
+	require.ErrorIs(t, err, ErrEmptyQuery)
+
 	users, _, err := client.User.Search(ctx, "test", nil)
 	require.NoError(t, err)
 	require.Equal(t, expectedSearchUsers, users)
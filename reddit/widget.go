@@ -0,0 +1,241 @@
+package reddit
+
+import (
+	"encoding/json"
+)
+
+const (
+	widgetKindTextArea      = "textarea"
+	widgetKindButton        = "button"
+	widgetKindRules         = "subreddit-rules"
+	widgetKindCommunityList = "community-list"
+	widgetKindImage         = "image"
+)
+
+// Widget is a section of useful content on a subreddit.
+// They can display information such as rules, links, the subreddit's flairs, etc.
+// Use a type switch to get the specific type of widget, e.g. *TextAreaWidget.
+type Widget interface {
+	// GetID returns the widget's ID.
+	GetID() string
+	kind() string
+}
+
+// Widgets holds the widgets of a subreddit, grouped by where they are displayed.
+type Widgets struct {
+	// The widgets displayed in the subreddit's sidebar, in order.
+	Sidebar []Widget
+	// The widgets displayed in the subreddit's topbar, in order.
+	Topbar []Widget
+	// The widget describing the subreddit, displayed at the top of the sidebar.
+	IDCard Widget
+	// The widget listing the subreddit's moderators.
+	Moderators Widget
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Reddit returns the widgets as a map keyed by ID, along with the layout that references them.
+func (w *Widgets) UnmarshalJSON(data []byte) error {
+	root := new(struct {
+		Items  map[string]rootWidget `json:"items"`
+		Layout struct {
+			IDCard     string `json:"idCardWidget"`
+			Moderators string `json:"moderatorWidget"`
+			Sidebar    struct {
+				Order []string `json:"order"`
+			} `json:"sidebar"`
+			Topbar struct {
+				Order []string `json:"order"`
+			} `json:"topbar"`
+		} `json:"layout"`
+	})
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	ordered := func(ids []string) []Widget {
+		widgets := make([]Widget, 0, len(ids))
+		for _, id := range ids {
+			if widget, ok := root.Items[id]; ok {
+				widgets = append(widgets, widget.Data)
+			}
+		}
+		return widgets
+	}
+
+	w.Sidebar = ordered(root.Layout.Sidebar.Order)
+	w.Topbar = ordered(root.Layout.Topbar.Order)
+	w.IDCard = root.Items[root.Layout.IDCard].Data
+	w.Moderators = root.Items[root.Layout.Moderators].Data
+
+	return nil
+}
+
+type rootWidget struct {
+	Data Widget
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *rootWidget) UnmarshalJSON(data []byte) error {
+	root := new(struct {
+		Kind string `json:"kind"`
+	})
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	switch root.Kind {
+	case widgetKindTextArea:
+		w.Data = new(TextAreaWidget)
+	case widgetKindButton:
+		w.Data = new(ButtonWidget)
+	case widgetKindRules:
+		w.Data = new(RulesWidget)
+	case widgetKindCommunityList:
+		w.Data = new(CommunityListWidget)
+	case widgetKindImage:
+		w.Data = new(ImageWidget)
+	default:
+		// data must be copied since it may be reused after this returns
+		w.Data = &UnknownWidget{Raw: append(json.RawMessage(nil), data...)}
+	}
+
+	return json.Unmarshal(data, w.Data)
+}
+
+// TextAreaWidget displays a block of text.
+type TextAreaWidget struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"shortName,omitempty"`
+	Text string `json:"text,omitempty"`
+}
+
+// GetID returns the widget's ID.
+func (w *TextAreaWidget) GetID() string {
+	return w.ID
+}
+
+func (*TextAreaWidget) kind() string {
+	return widgetKindTextArea
+}
+
+// ButtonWidget displays a list of buttons that link elsewhere.
+type ButtonWidget struct {
+	ID          string          `json:"id,omitempty"`
+	Name        string          `json:"shortName,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Buttons     []*WidgetButton `json:"buttons,omitempty"`
+}
+
+// GetID returns the widget's ID.
+func (w *ButtonWidget) GetID() string {
+	return w.ID
+}
+
+func (*ButtonWidget) kind() string {
+	return widgetKindButton
+}
+
+// WidgetButton is a button in a button widget.
+type WidgetButton struct {
+	// One of: text, image.
+	Kind string `json:"kind,omitempty"`
+	Text string `json:"text,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// RulesWidget displays the subreddit's rules.
+type RulesWidget struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"shortName,omitempty"`
+	// One of: full, compact.
+	Display string        `json:"display,omitempty"`
+	Rules   []*WidgetRule `json:"data,omitempty"`
+}
+
+// GetID returns the widget's ID.
+func (w *RulesWidget) GetID() string {
+	return w.ID
+}
+
+func (*RulesWidget) kind() string {
+	return widgetKindRules
+}
+
+// WidgetRule is a rule in a rules widget.
+type WidgetRule struct {
+	Name        string `json:"shortName,omitempty"`
+	Description string `json:"description,omitempty"`
+	// The reason shown to users when reporting content that breaks the rule.
+	ViolationReason string `json:"violationReason,omitempty"`
+}
+
+// CommunityListWidget displays a list of related subreddits.
+type CommunityListWidget struct {
+	ID          string             `json:"id,omitempty"`
+	Name        string             `json:"shortName,omitempty"`
+	Communities []*WidgetCommunity `json:"data,omitempty"`
+}
+
+// GetID returns the widget's ID.
+func (w *CommunityListWidget) GetID() string {
+	return w.ID
+}
+
+func (*CommunityListWidget) kind() string {
+	return widgetKindCommunityList
+}
+
+// WidgetCommunity is a subreddit in a community list widget.
+type WidgetCommunity struct {
+	Name        string `json:"name,omitempty"`
+	Subscribers int    `json:"subscribers"`
+	NSFW        bool   `json:"isNSFW"`
+}
+
+// ImageWidget displays one or more images.
+type ImageWidget struct {
+	ID     string         `json:"id,omitempty"`
+	Name   string         `json:"shortName,omitempty"`
+	Images []*WidgetImage `json:"data,omitempty"`
+}
+
+// GetID returns the widget's ID.
+func (w *ImageWidget) GetID() string {
+	return w.ID
+}
+
+func (*ImageWidget) kind() string {
+	return widgetKindImage
+}
+
+// WidgetImage is an image in an image widget.
+type WidgetImage struct {
+	URL string `json:"url,omitempty"`
+	// The URL that the image links to.
+	LinkURL string `json:"linkUrl,omitempty"`
+	Height  int    `json:"height"`
+	Width   int    `json:"width"`
+}
+
+// UnknownWidget is a widget of a kind that is not explicitly supported, e.g. id-card, menu, calendar.
+// Its raw JSON is kept so it can be decoded by the caller.
+type UnknownWidget struct {
+	ID   string          `json:"id,omitempty"`
+	Kind string          `json:"kind,omitempty"`
+	Name string          `json:"shortName,omitempty"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+// GetID returns the widget's ID.
+func (w *UnknownWidget) GetID() string {
+	return w.ID
+}
+
+func (w *UnknownWidget) kind() string {
+	return w.Kind
+}
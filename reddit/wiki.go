@@ -0,0 +1,107 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// WikiService handles communication with the wiki
+// related methods of the Reddit API.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_wiki
+type WikiService struct {
+	client *Client
+}
+
+// WikiPage is a page of a subreddit's wiki.
+type WikiPage struct {
+	Content     string `json:"content_md"`
+	ContentHTML string `json:"content_html"`
+	// The reason given for the latest revision.
+	Reason string `json:"reason"`
+	// Whether you're allowed to edit the page.
+	MayRevise bool `json:"may_revise"`
+
+	RevisionID   string     `json:"revision_id"`
+	RevisionDate *Timestamp `json:"revision_date"`
+	RevisionBy   *User      `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *WikiPage) UnmarshalJSON(b []byte) error {
+	type alias WikiPage
+	root := new(struct {
+		*alias
+		RevisionBy *struct {
+			Data *User `json:"data"`
+		} `json:"revision_by"`
+	})
+	root.alias = (*alias)(p)
+
+	if err := json.Unmarshal(b, root); err != nil {
+		return err
+	}
+
+	if root.RevisionBy != nil {
+		p.RevisionBy = root.RevisionBy.Data
+	}
+
+	return nil
+}
+
+// Page gets a page of the subreddit's wiki.
+func (s *WikiService) Page(ctx context.Context, subreddit, page string) (*WikiPage, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+	if page == "" {
+		return nil, nil, errors.New("page: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/wiki/%s", subreddit, page)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data *WikiPage `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+// Edit sets the content of a page of the subreddit's wiki, creating it if it doesn't exist.
+// The reason is optional and shows up in the page's revision history.
+func (s *WikiService) Edit(ctx context.Context, subreddit, page, content, reason string) (*Response, error) {
+	if subreddit == "" {
+		return nil, errors.New("subreddit: cannot be empty")
+	}
+	if page == "" {
+		return nil, errors.New("page: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/wiki/edit", subreddit)
+
+	form := newJSONForm()
+	form.Set("page", page)
+	form.Set("content", content)
+	if reason != "" {
+		form.Set("reason", reason)
+	}
+
+	req, err := s.client.NewRequestWithForm(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,79 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var expectedWikiPage = &WikiPage{
+	Content:     "# Welcome\n\nRead the rules before posting.",
+	ContentHTML: "<!-- SC_OFF --><div class=\"md wiki\"><h1>Welcome</h1>\n\n<p>Read the rules before posting.</p>\n</div><!-- SC_ON -->",
+	Reason:      "update welcome message",
+	MayRevise:   true,
+
+	RevisionID:   "4b3bdd58-c4ad-11ea-a4b1-0e2ab6ef0d9b",
+	RevisionDate: &Timestamp{time.Date(2020, 7, 13, 2, 8, 14, 0, time.UTC)},
+	RevisionBy: &User{
+		ID:           "164ab8",
+		Name:         "v_95",
+		Created:      &Timestamp{time.Date(2019, 5, 25, 22, 20, 48, 0, time.UTC)},
+		PostKarma:    1,
+		CommentKarma: 2,
+	},
+}
+
+func TestWikiService_Page(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/wiki/page.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/wiki/index", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Wiki.Page(ctx, "", "index")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Wiki.Page(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "page: cannot be empty")
+
+	page, _, err := client.Wiki.Page(ctx, "testsubreddit", "index")
+	require.NoError(t, err)
+	require.Equal(t, expectedWikiPage, page)
+}
+
+func TestWikiService_Edit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/api/wiki/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("page", "index")
+		form.Set("content", "# Welcome")
+		form.Set("reason", "test reason")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Wiki.Edit(ctx, "", "index", "# Welcome", "test reason")
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, err = client.Wiki.Edit(ctx, "testsubreddit", "", "# Welcome", "test reason")
+	require.EqualError(t, err, "page: cannot be empty")
+
+	_, err = client.Wiki.Edit(ctx, "testsubreddit", "index", "# Welcome", "test reason")
+	require.NoError(t, err)
+}